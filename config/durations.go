@@ -0,0 +1,20 @@
+package config
+
+import (
+	"log"
+	"time"
+)
+
+func ParseDuration(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Printf("invalid duration %q, using %s\n", value, fallback)
+		return fallback
+	}
+
+	return duration
+}
@@ -11,4 +11,37 @@ var (
 	MYSQL_USER   = os.Getenv("MYSQL_USER")
 	MYSQL_PASS   = os.Getenv("MYSQL_PASS")
 	MYSQL_DBNAME = os.Getenv("MYSQL_DBNAME")
+
+	/////////////////////////////////////////// AUTHENTICATION
+	JWT_HMAC_SECRET         = os.Getenv("JWT_HMAC_SECRET")
+	JWT_RSA_PUBLIC_KEY_FILE = os.Getenv("JWT_RSA_PUBLIC_KEY_FILE")
+	JWT_ISSUER              = os.Getenv("JWT_ISSUER")
+	JWT_AUDIENCE            = os.Getenv("JWT_AUDIENCE")
+
+	/////////////////////////////////////////// RATE LIMITS
+	RATE_LIMIT_DEFAULT_PER_MINUTE        = os.Getenv("RATE_LIMIT_DEFAULT_PER_MINUTE")
+	RATE_LIMIT_CREATE_POST_PER_MINUTE    = os.Getenv("RATE_LIMIT_CREATE_POST_PER_MINUTE")
+	RATE_LIMIT_CREATE_COMMENT_PER_MINUTE = os.Getenv("RATE_LIMIT_CREATE_COMMENT_PER_MINUTE")
+	RATE_LIMIT_UPDATE_LIKES_PER_MINUTE   = os.Getenv("RATE_LIMIT_UPDATE_LIKES_PER_MINUTE")
+	DAILY_POST_QUOTA                     = os.Getenv("DAILY_POST_QUOTA")
+
+	/////////////////////////////////////////// JOBS
+	SCHEDULED_POSTS_INTERVAL     = os.Getenv("SCHEDULED_POSTS_INTERVAL")
+	PURGE_DELETED_POSTS_INTERVAL = os.Getenv("PURGE_DELETED_POSTS_INTERVAL")
+	MODERATION_RELOAD_INTERVAL   = os.Getenv("MODERATION_RELOAD_INTERVAL")
+	TRENDING_SCORES_INTERVAL     = os.Getenv("TRENDING_SCORES_INTERVAL")
+	POST_COUNTERS_INTERVAL       = os.Getenv("POST_COUNTERS_INTERVAL")
+
+	/////////////////////////////////////////// POSTS
+	POST_RESTORE_WINDOW   = os.Getenv("POST_RESTORE_WINDOW")
+	TRENDING_POSTS_WINDOW = os.Getenv("TRENDING_POSTS_WINDOW")
+
+	/////////////////////////////////////////// TIMELINES
+	TIMELINE_WORKERS              = os.Getenv("TIMELINE_WORKERS")
+	TIMELINE_QUEUE_SIZE           = os.Getenv("TIMELINE_QUEUE_SIZE")
+	TIMELINE_FANOUT_MAX_FOLLOWERS = os.Getenv("TIMELINE_FANOUT_MAX_FOLLOWERS")
+
+	/////////////////////////////////////////// MODERATION
+	MODERATION_RULES_FILE  = os.Getenv("MODERATION_RULES_FILE")
+	REPORTS_HIDE_THRESHOLD = os.Getenv("REPORTS_HIDE_THRESHOLD")
 )
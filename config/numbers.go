@@ -0,0 +1,20 @@
+package config
+
+import (
+	"log"
+	"strconv"
+)
+
+func ParseInt(value string, fallback int64) int64 {
+	if value == "" {
+		return fallback
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number <= 0 {
+		log.Printf("invalid number %q, using %d\n", value, fallback)
+		return fallback
+	}
+
+	return number
+}
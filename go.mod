@@ -18,3 +18,7 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250804133106-a7a43d27e69b // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
 )
+
+// The proto changes this service needs are not published in lib-relaunch-cot
+// yet. Drop this once the module is bumped to a release that has them.
+replace github.com/relaunch-cot/lib-relaunch-cot => ./third_party/lib-relaunch-cot
@@ -2,46 +2,155 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
 	pb "github.com/relaunch-cot/lib-relaunch-cot/proto/post"
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/models"
 	"github.com/relaunch-cot/service-post/repositories"
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"github.com/relaunch-cot/service-post/resource/authorization"
+	"github.com/relaunch-cot/service-post/resource/moderation"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"github.com/relaunch-cot/service-post/resource/parser"
+	"github.com/relaunch-cot/service-post/resource/ratelimit"
 	"github.com/relaunch-cot/service-post/resource/transformer"
+	"github.com/relaunch-cot/service-post/resource/workerpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultPostRestoreWindow          = 30 * 24 * time.Hour
+	purgeDeletedPostsBatchSize  int64 = 500
+	defaultTrendingTagsWindow         = 24 * time.Hour
+	defaultTrendingPostsWindow        = 7 * 24 * time.Hour
+	trendingCommentWeight             = 2
+	trendingGravity                   = 1.8
+	defaultFanOutMaxFollowers         = 10000
+	timelineBackfillLimit             = 100
+	timelineSubmitTimeout             = 2 * time.Second
+	defaultReportsHideThreshold       = 5
+	defaultDailyPostQuota             = 50
+	dailyPostQuotaWindow              = 24 * time.Hour
+)
+
 type resource struct {
 	repositories *repositories.Repositories
+	moderator    *moderation.Moderator
+	authorizer   *authorization.Authorizer
+	timelines    *workerpool.Pool
 }
 
 type IPostHandler interface {
-	CreatePost(ctx *context.Context, in *pb.CreatePostRequest) error
-	GetPost(ctx *context.Context, in *pb.GetPostRequest) (*pb.GetPostResponse, error)
-	GetAllPosts(ctx *context.Context) (*pb.GetAllPostsResponse, error)
-	GetAllPostsFromUser(ctx *context.Context, in *pb.GetAllPostsFromUserRequest) (*pb.GetAllPostsFromUserResponse, error)
-	UpdatePost(ctx *context.Context, in *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error)
+	CreatePost(ctx *context.Context, in *pb.CreatePostRequest, options *models.PostOptions) error
+	GetPost(ctx *context.Context, in *pb.GetPostRequest, viewerId string) (*pb.GetPostResponse, error)
+	GetAllPosts(ctx *context.Context, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error)
+	GetTrendingPosts(ctx *context.Context, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error)
+	RecomputeTrendingScores(ctx *context.Context) (int64, error)
+	ReconcilePostCounters(ctx *context.Context) (int64, error)
+	GetAllPostsFromUser(ctx *context.Context, in *pb.GetAllPostsFromUserRequest, viewerId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error)
+	ListPosts(ctx *context.Context, in *models.ListPostsParams) (*pb.GetAllPostsResponse, error)
+	SearchPosts(ctx *context.Context, in *models.SearchPostsParams) (*pb.GetAllPostsResponse, error)
+	UpdatePost(ctx *context.Context, in *pb.UpdatePostRequest, options *models.PostOptions) (*pb.UpdatePostResponse, error)
+	GetAllRevisionsFromPost(ctx *context.Context, postId, viewerId string) ([]*models.PostRevision, error)
+	GetPostRevision(ctx *context.Context, postId, viewerId string, revisionNumber int64) (*models.PostRevision, error)
+	DiffPostRevisions(ctx *context.Context, postId, viewerId string, fromRevisionNumber, toRevisionNumber int64) ([]*models.PostRevisionFieldDiff, error)
 	DeletePost(ctx *context.Context, in *pb.DeletePostRequest) error
-	GetAllLikesFromPost(ctx *context.Context, in *pb.GetAllLikesFromPostRequest) (*pb.GetAllLikesFromPostResponse, error)
-	UpdateLikesFromPostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, error)
+	PinPost(ctx *context.Context, postId, userId string) error
+	UnpinPost(ctx *context.Context, postId, userId string) error
+	Repost(ctx *context.Context, postId, userId string) error
+	Unrepost(ctx *context.Context, postId, userId string) error
+	GetAllRepostsFromUser(ctx *context.Context, userId, viewerId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error)
+	BookmarkPost(ctx *context.Context, postId, userId string) error
+	RemoveBookmark(ctx *context.Context, postId, userId string) error
+	ListBookmarks(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error)
+	FollowUser(ctx *context.Context, userId, followingId string) error
+	UnfollowUser(ctx *context.Context, userId, followingId string) error
+	GetHomeTimeline(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsResponse, error)
+	RestorePost(ctx *context.Context, postId, userId string) error
+	PurgeDeletedPosts(ctx *context.Context) (int64, error)
+	CreateDraft(ctx *context.Context, in *models.PostDraftParams) (*models.Post, error)
+	UpdateDraft(ctx *context.Context, in *models.PostDraftParams) (*pb.UpdatePostResponse, error)
+	PublishDraft(ctx *context.Context, postId, userId string) error
+	GetAllDraftsFromUser(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error)
+	PublishScheduledPosts(ctx *context.Context) (int64, error)
+	GetAllPostsFromTag(ctx *context.Context, tag, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error)
+	GetTrendingTags(ctx *context.Context, window time.Duration, limit int64) (*pb.GetTrendingTagsResponse, error)
+	GetAllMentionsFromUser(ctx *context.Context, userId, cursor string, limit int64) ([]*models.Mention, string, error)
+	ReloadModerationRules() error
+	ReportContent(ctx *context.Context, in *models.ReportContentParams) error
+	GetModerationQueue(ctx *context.Context, moderatorId, cursor string, limit int64) (*pb.GetModerationQueueResponse, error)
+	ApproveContent(ctx *context.Context, in *models.ModerationActionParams) error
+	RemoveContent(ctx *context.Context, in *models.ModerationActionParams) error
+	RestoreContent(ctx *context.Context, in *models.ModerationActionParams) error
+	GetAllLikesFromPost(ctx *context.Context, in *pb.GetAllLikesFromPostRequest) (*pb.GetAllLikesFromPostResponse, *models.ReactionSummary, error)
+	UpdateLikesFromPostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest, reaction string) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error)
+	LikePostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest, reaction string) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error)
+	UnlikePostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error)
 	CreateCommentOrReply(ctx *context.Context, in *pb.CreateCommentOrReplyRequest) (*pb.CreateCommentOrReplyResponse, error)
 	DeleteCommentOrReply(ctx *context.Context, in *pb.DeleteCommentOrReplyRequest) error
 	GetAllCommentsFromPost(ctx *context.Context, in *pb.GetAllCommentsFromPostRequest) (*pb.GetAllCommentsFromPostResponse, error)
 }
 
-func (r *resource) CreatePost(ctx *context.Context, in *pb.CreatePostRequest) error {
+func (r *resource) CreatePost(ctx *context.Context, in *pb.CreatePostRequest, options *models.PostOptions) error {
+	err := requireCaller(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	err = r.checkDailyPostQuota(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	visibility := options.Visibility
+	if visibility == "" {
+		visibility = models.PostVisibilityPublic
+	}
+	err = validateVisibility(visibility)
+	if err != nil {
+		return err
+	}
+
+	err = validateAttachments(options.Attachments)
+	if err != nil {
+		return err
+	}
+
+	err = r.validateQuotedPost(ctx, options.QuotedPostId, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	title, content, holdReason, err := r.moderatePost(in.Title, in.Content)
+	if err != nil {
+		return err
+	}
+
 	postId := uuid.New().String()
-	err := r.repositories.Mysql.CreatePost(ctx, in.UserId, postId, in.Title, in.Content, in.Type, in.UrlImagePost)
+	err = r.repositories.Mysql.CreatePost(ctx, in.UserId, postId, title, content, in.Type, in.UrlImagePost, visibility, models.PostStatusPublished, moderationStatusFromHoldReason(holdReason, models.ModerationStatusVisible), options.QuotedPostId, holdReason, nil, options.Attachments, parser.ExtractHashtags(content), parser.ExtractMentions(content))
 	if err != nil {
 		return err
 	}
 
+	// The post is stored by now: a timeline failure must not make the
+	// client retry and create it twice.
+	err = r.fanOutPost(ctx, postId)
+	if err != nil {
+		log.Printf("fan out post %q failed: %v\n", postId, err)
+	}
+
 	return nil
 }
 
-func (r *resource) GetPost(ctx *context.Context, in *pb.GetPostRequest) (*pb.GetPostResponse, error) {
-	response, err := r.repositories.Mysql.GetPost(ctx, in.PostId)
+func (r *resource) GetPost(ctx *context.Context, in *pb.GetPostRequest, viewerId string) (*pb.GetPostResponse, error) {
+	response, err := r.repositories.Mysql.GetPost(ctx, in.PostId, viewerId)
 	if err != nil {
 		return nil, err
 	}
@@ -58,193 +167,1708 @@ func (r *resource) GetPost(ctx *context.Context, in *pb.GetPostRequest) (*pb.Get
 	return getPostResponse, nil
 }
 
-func (r *resource) GetAllPosts(ctx *context.Context) (*pb.GetAllPostsResponse, error) {
-	response, err := r.repositories.Mysql.GetAllPosts(ctx)
+func (r *resource) GetAllPosts(ctx *context.Context, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error) {
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	response, err := r.repositories.Mysql.GetAllPosts(ctx, viewerId, decodedCursor, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
+	response, nextCursor := paginatePosts(response, limit)
+
 	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
 	if err != nil {
 		return nil, err
 	}
 
 	getAllPostsResponse := &pb.GetAllPostsResponse{
-		Posts: baseModelsPosts,
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
 	}
 
 	return getAllPostsResponse, nil
 }
 
-func (r *resource) GetAllPostsFromUser(ctx *context.Context, in *pb.GetAllPostsFromUserRequest) (*pb.GetAllPostsFromUserResponse, error) {
-	response, err := r.repositories.Mysql.GetAllPostsFromUser(ctx, in.UserId)
+func (r *resource) GetTrendingPosts(ctx *context.Context, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error) {
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	response, err := r.repositories.Mysql.GetTrendingPosts(ctx, viewerId, offset, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(response)) > limit {
+		response = response[:limit]
+		nextCursor = pagination.EncodeOffsetCursor(offset + limit)
+	}
+
+	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
+	if err != nil {
+		return nil, err
+	}
+
+	getTrendingPostsResponse := &pb.GetAllPostsResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return getTrendingPostsResponse, nil
+}
+
+func (r *resource) RecomputeTrendingScores(ctx *context.Context) (int64, error) {
+	now := time.Now()
+	window := config.ParseDuration(config.TRENDING_POSTS_WINDOW, defaultTrendingPostsWindow)
+	scored, err := r.repositories.Mysql.RecomputePostScores(ctx, now, now.Add(-window), trendingCommentWeight, trendingGravity)
+	if err != nil {
+		return 0, err
+	}
+
+	return scored, nil
+}
+
+func (r *resource) ReconcilePostCounters(ctx *context.Context) (int64, error) {
+	reconciled, err := r.repositories.Mysql.RecomputePostCounters(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return reconciled, nil
+}
+
+func (r *resource) GetAllPostsFromUser(ctx *context.Context, in *pb.GetAllPostsFromUserRequest, viewerId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error) {
+	decodedCursor, err := pagination.DecodeCursor(cursor)
 	if err != nil {
 		return nil, err
 	}
 
+	limit = pagination.NormalizeLimit(limit)
+	response, err := r.repositories.Mysql.GetAllPostsFromUser(ctx, in.UserId, viewerId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, nextCursor := paginatePosts(response, limit)
+
+	// Pinned posts are left out of the paginated listing and lead its first page.
+	if decodedCursor == nil {
+		pinnedPosts, err := r.repositories.Mysql.GetPinnedPostsFromUser(ctx, in.UserId, viewerId)
+		if err != nil {
+			return nil, err
+		}
+
+		response = append(pinnedPosts, response...)
+	}
+
 	baseModelsPosts, err := transformer.GetAllPostsFromUserToBaseModels(response)
 	if err != nil {
 		return nil, err
 	}
 
 	getAllPostsFromUserResponse := &pb.GetAllPostsFromUserResponse{
-		Posts: baseModelsPosts,
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
 	}
 
 	return getAllPostsFromUserResponse, nil
 }
 
-func (r *resource) UpdatePost(ctx *context.Context, in *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
-	err := r.repositories.Mysql.UpdatePost(ctx, in.PostId, in.UserId, in.Title, in.Content, in.UrlImagePost)
+func (r *resource) ListPosts(ctx *context.Context, in *models.ListPostsParams) (*pb.GetAllPostsResponse, error) {
+	sortBy := in.SortBy
+	if sortBy == "" {
+		sortBy = models.PostSortNewest
+	}
+
+	var decodedCursor *pagination.Cursor
+	var offset int64
+	var err error
+	switch sortBy {
+	case models.PostSortNewest, models.PostSortOldest:
+		decodedCursor, err = pagination.DecodeCursor(in.Cursor)
+	case models.PostSortMostLiked, models.PostSortMostCommented:
+		offset, err = pagination.DecodeOffsetCursor(in.Cursor)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid sort mode")
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	post, err := r.repositories.Mysql.GetPost(ctx, in.PostId)
+	limit := pagination.NormalizeLimit(in.Limit)
+	response, err := r.repositories.Mysql.ListPosts(ctx, in.ViewerId, &in.Filters, sortBy, decodedCursor, offset, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
-	baseModelsPost, err := transformer.GetPostToBaseModels(post)
+	nextCursor := ""
+	if sortBy == models.PostSortNewest || sortBy == models.PostSortOldest {
+		response, nextCursor = paginatePosts(response, limit)
+	} else if int64(len(response)) > limit {
+		response = response[:limit]
+		nextCursor = pagination.EncodeOffsetCursor(offset + limit)
+	}
+
+	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
 	if err != nil {
 		return nil, err
 	}
 
-	updatePostResponse := &pb.UpdatePostResponse{
-		Post: baseModelsPost,
+	listPostsResponse := &pb.GetAllPostsResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
 	}
 
-	return updatePostResponse, nil
+	return listPostsResponse, nil
 }
 
-func (r *resource) DeletePost(ctx *context.Context, in *pb.DeletePostRequest) error {
-	err := r.repositories.Mysql.DeletePost(ctx, in.PostId, in.UserId)
+func (r *resource) SearchPosts(ctx *context.Context, in *models.SearchPostsParams) (*pb.GetAllPostsResponse, error) {
+	searchQuery := strings.TrimSpace(in.Query)
+	if searchQuery == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is required")
+	}
+
+	orderBy := in.OrderBy
+	if orderBy == "" {
+		orderBy = models.SearchOrderRelevance
+	}
+	if orderBy != models.SearchOrderRelevance && orderBy != models.SearchOrderRecency {
+		return nil, status.Error(codes.InvalidArgument, "invalid search order")
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(in.Cursor)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	limit := pagination.NormalizeLimit(in.Limit)
+	response, err := r.repositories.Mysql.SearchPosts(ctx, in.ViewerId, searchQuery, in.Types, orderBy, offset, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(response)) > limit {
+		response = response[:limit]
+		nextCursor = pagination.EncodeOffsetCursor(offset + limit)
+	}
+
+	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
+	if err != nil {
+		return nil, err
+	}
+
+	searchPostsResponse := &pb.GetAllPostsResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return searchPostsResponse, nil
 }
 
-func (r *resource) GetAllLikesFromPost(ctx *context.Context, in *pb.GetAllLikesFromPostRequest) (*pb.GetAllLikesFromPostResponse, error) {
-	allLikesFromPost, err := r.repositories.Mysql.GetAllLikesFromPost(ctx, in.PostId, in.UserId)
+func (r *resource) UpdatePost(ctx *context.Context, in *pb.UpdatePostRequest, options *models.PostOptions) (*pb.UpdatePostResponse, error) {
+	if options.Visibility != "" {
+		err := validateVisibility(options.Visibility)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := validateAttachments(options.Attachments)
 	if err != nil {
 		return nil, err
 	}
 
-	baseModelsAllLikesFromPost, err := transformer.GetAllLikesFromPostOrCommentToBaseModels(allLikesFromPost)
+	target, err := r.getTarget(ctx, models.MentionTargetPost, in.PostId)
 	if err != nil {
 		return nil, err
 	}
 
-	getLikesFromPostResponse := &pb.GetAllLikesFromPostResponse{
-		LikesFromPost: baseModelsAllLikesFromPost,
+	_, err = r.authorize(ctx, in.UserId, authorization.ActionUpdatePost, target)
+	if err != nil {
+		return nil, err
 	}
 
-	return getLikesFromPostResponse, nil
-}
+	title, content, holdReason, err := r.moderatePost(in.Title, in.Content)
+	if err != nil {
+		return nil, err
+	}
 
-func (r *resource) UpdateLikesFromPostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, error) {
-	err := r.repositories.Mysql.UpdateLikesFromPostOrComments(ctx, in.PostId, in.CommentId, in.UserId, in.Type)
+	err = r.repositories.Mysql.UpdatePost(ctx, in.PostId, in.UserId, title, content, in.UrlImagePost, options.Visibility, moderationStatusFromHoldReason(holdReason, ""), options.Attachments)
 	if err != nil {
 		return nil, err
 	}
 
-	likesFromPostOrComment := new(libModels.PostLikes)
-	if in.Type == "likeToPost" {
-		likesFromPostOrComment, err = r.repositories.Mysql.GetAllLikesFromPost(ctx, in.PostId, in.UserId)
-	} else if in.Type == "likeToComment" {
-		likesFromPostOrComment, err = r.repositories.Mysql.GetAllLikesFromComment(ctx, in.CommentId, in.UserId)
-	} else {
-		return nil, status.Error(codes.InvalidArgument, "invalid like type")
+	err = r.holdForModeration(ctx, models.MentionTargetPost, in.PostId, in.PostId, holdReason)
+	if err != nil {
+		return nil, err
+	}
+
+	if content != "" {
+		err = r.syncPostContentReferences(ctx, in.PostId, in.UserId, content)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	post, err := r.repositories.Mysql.GetAuthorizedPost(ctx, in.PostId, in.UserId)
 	if err != nil {
 		return nil, err
 	}
 
-	baseModelsLikesFromPostOrComment, err := transformer.GetAllLikesFromPostOrCommentToBaseModels(likesFromPostOrComment)
+	baseModelsPost, err := transformer.GetPostToBaseModels(post)
 	if err != nil {
 		return nil, err
 	}
 
-	updateLikesFromPostOrCommentResponse := &pb.UpdateLikesFromPostOrCommentResponse{
-		LikesFromPostOrComment: baseModelsLikesFromPostOrComment,
+	updatePostResponse := &pb.UpdatePostResponse{
+		Post: baseModelsPost,
 	}
 
-	return updateLikesFromPostOrCommentResponse, nil
+	return updatePostResponse, nil
 }
 
-func (r *resource) CreateCommentOrReply(ctx *context.Context, in *pb.CreateCommentOrReplyRequest) (*pb.CreateCommentOrReplyResponse, error) {
-	var err error
+func (r *resource) GetAllRevisionsFromPost(ctx *context.Context, postId, viewerId string) ([]*models.PostRevision, error) {
+	_, err := r.repositories.Mysql.GetPost(ctx, postId, viewerId)
+	if err != nil {
+		return nil, err
+	}
 
-	if in.Type == "comment" {
-		commentId := uuid.New().String()
-		err = r.repositories.Mysql.CreateComment(ctx, in.PostId, commentId, in.UserId, in.Content)
-	} else if in.Type == "reply" {
-		replyId := uuid.New().String()
-		err = r.repositories.Mysql.CreateReply(ctx, in.ParentCommentId, replyId, in.UserId, in.Content)
-	} else {
-		return nil, status.Error(codes.InvalidArgument, "invalid comment type")
+	revisions, err := r.repositories.Mysql.GetAllRevisionsFromPost(ctx, postId)
+	if err != nil {
+		return nil, err
 	}
 
+	return revisions, nil
+}
+
+func (r *resource) GetPostRevision(ctx *context.Context, postId, viewerId string, revisionNumber int64) (*models.PostRevision, error) {
+	_, err := r.repositories.Mysql.GetPost(ctx, postId, viewerId)
 	if err != nil {
 		return nil, err
 	}
 
-	comments, err := r.repositories.Mysql.GetAllCommentsFromPost(ctx, in.PostId, in.UserId)
+	revision, err := r.repositories.Mysql.GetRevision(ctx, postId, revisionNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	baseModelsComment, err := transformer.GetAllCommentsFromPostToBaseModels(comments)
+	return revision, nil
+}
+
+func (r *resource) DiffPostRevisions(ctx *context.Context, postId, viewerId string, fromRevisionNumber, toRevisionNumber int64) ([]*models.PostRevisionFieldDiff, error) {
+	fromRevision, err := r.GetPostRevision(ctx, postId, viewerId, fromRevisionNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	addCommentToPostResponse := &pb.CreateCommentOrReplyResponse{
-		CommentsFromPost: baseModelsComment,
+	toRevision, err := r.repositories.Mysql.GetRevision(ctx, postId, toRevisionNumber)
+	if err != nil {
+		return nil, err
 	}
 
-	return addCommentToPostResponse, nil
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"title", fromRevision.Title, toRevision.Title},
+		{"content", fromRevision.Content, toRevision.Content},
+		{"urlImagePost", fromRevision.UrlImagePost, toRevision.UrlImagePost},
+	}
+
+	diffs := make([]*models.PostRevisionFieldDiff, 0)
+	for _, field := range fields {
+		if field.from == field.to {
+			continue
+		}
+
+		diffs = append(diffs, &models.PostRevisionFieldDiff{
+			Field: field.name,
+			From:  field.from,
+			To:    field.to,
+		})
+	}
+
+	return diffs, nil
 }
 
-func (r *resource) DeleteCommentOrReply(ctx *context.Context, in *pb.DeleteCommentOrReplyRequest) error {
-	var err error
+func (r *resource) DeletePost(ctx *context.Context, in *pb.DeletePostRequest) error {
+	target, err := r.getTarget(ctx, models.MentionTargetPost, in.PostId)
+	if err != nil {
+		return err
+	}
 
-	if in.Type == "comment" {
-		err = r.repositories.Mysql.DeleteComment(ctx, in.CommentId, in.UserId)
-	} else if in.Type == "reply" {
-		err = r.repositories.Mysql.DeleteReply(ctx, in.ReplyId, in.UserId)
-	} else {
-		return status.Error(codes.InvalidArgument, "invalid comment type")
+	subject, err := r.authorize(ctx, in.UserId, authorization.ActionDeletePost, target)
+	if err != nil {
+		return err
 	}
+
+	err = r.repositories.Mysql.DeletePost(ctx, in.PostId, staffDeleteAction(subject, target, models.MentionTargetPost, in.PostId))
 	if err != nil {
 		return err
 	}
 
+	r.removeFromTimelines(ctx, in.PostId)
+
 	return nil
 }
 
-func (r *resource) GetAllCommentsFromPost(ctx *context.Context, in *pb.GetAllCommentsFromPostRequest) (*pb.GetAllCommentsFromPostResponse, error) {
-	comments, err := r.repositories.Mysql.GetAllCommentsFromPost(ctx, in.PostId, in.UserId)
+func (r *resource) PinPost(ctx *context.Context, postId, userId string) error {
+	target, err := r.getTarget(ctx, models.MentionTargetPost, postId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.authorize(ctx, userId, authorization.ActionPinPost, target)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.PinPost(ctx, postId, userId, models.MaxPinnedPostsPerUser)
+}
+
+func (r *resource) UnpinPost(ctx *context.Context, postId, userId string) error {
+	target, err := r.getTarget(ctx, models.MentionTargetPost, postId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.authorize(ctx, userId, authorization.ActionPinPost, target)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.UnpinPost(ctx, postId)
+}
+
+func (r *resource) Repost(ctx *context.Context, postId, userId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, postId, userId)
+	if err != nil {
+		return err
+	}
+
+	err = r.repositories.Mysql.CreateRepost(ctx, userId, postId)
+	if err != nil {
+		return err
+	}
+
+	return r.fanOutRepost(ctx, userId, postId)
+}
+
+func (r *resource) Unrepost(ctx *context.Context, postId, userId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.DeleteRepost(ctx, userId, postId)
+}
+
+func (r *resource) GetAllRepostsFromUser(ctx *context.Context, userId, viewerId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error) {
+	err := checkViewer(ctx, viewerId)
 	if err != nil {
 		return nil, err
 	}
 
-	baseModelsComment, err := transformer.GetAllCommentsFromPostToBaseModels(comments)
+	decodedCursor, err := pagination.DecodeCursor(cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	getAllCommentsFromPostResponse := &pb.GetAllCommentsFromPostResponse{
-		CommentsFromPost: baseModelsComment,
+	limit = pagination.NormalizeLimit(limit)
+	reposts, err := r.repositories.Mysql.GetAllRepostsFromUser(ctx, userId, viewerId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
 	}
 
-	return getAllCommentsFromPostResponse, nil
+	nextCursor := ""
+	if int64(len(reposts)) > limit {
+		reposts = reposts[:limit]
+		lastRepost := reposts[len(reposts)-1]
+		nextCursor = pagination.EncodeCursor(lastRepost.CreatedAt, lastRepost.Post.PostId)
+	}
+
+	posts := make([]*models.Post, 0, len(reposts))
+	for _, repost := range reposts {
+		posts = append(posts, repost.Post)
+	}
+
+	baseModelsPosts, err := transformer.GetAllPostsFromUserToBaseModels(posts)
+	if err != nil {
+		return nil, err
+	}
+
+	getAllRepostsFromUserResponse := &pb.GetAllPostsFromUserResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return getAllRepostsFromUserResponse, nil
+}
+
+func (r *resource) BookmarkPost(ctx *context.Context, postId, userId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, postId, userId)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.CreateBookmark(ctx, userId, postId)
+}
+
+func (r *resource) RemoveBookmark(ctx *context.Context, postId, userId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.DeleteBookmark(ctx, userId, postId)
+}
+
+func (r *resource) ListBookmarks(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error) {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	bookmarks, err := r.repositories.Mysql.GetAllBookmarksFromUser(ctx, userId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(bookmarks)) > limit {
+		bookmarks = bookmarks[:limit]
+		lastBookmark := bookmarks[len(bookmarks)-1]
+		nextCursor = pagination.EncodeCursor(lastBookmark.CreatedAt, lastBookmark.Post.PostId)
+	}
+
+	posts := make([]*models.Post, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		posts = append(posts, bookmark.Post)
+	}
+
+	baseModelsPosts, err := transformer.GetAllPostsFromUserToBaseModels(posts)
+	if err != nil {
+		return nil, err
+	}
+
+	listBookmarksResponse := &pb.GetAllPostsFromUserResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return listBookmarksResponse, nil
+}
+
+func (r *resource) FollowUser(ctx *context.Context, userId, followingId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if followingId == "" {
+		return status.Error(codes.InvalidArgument, "user to follow is required")
+	}
+	if followingId == userId {
+		return status.Error(codes.InvalidArgument, "users cannot follow themselves")
+	}
+
+	err = r.repositories.Mysql.FollowUser(ctx, userId, followingId)
+	if err != nil {
+		return err
+	}
+
+	return r.backfillTimeline(ctx, userId, followingId)
+}
+
+func (r *resource) UnfollowUser(ctx *context.Context, userId, followingId string) error {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.UnfollowUser(ctx, userId, followingId)
+}
+
+func (r *resource) GetHomeTimeline(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsResponse, error) {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	response, err := r.repositories.Mysql.GetHomeTimeline(ctx, userId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, nextCursor := paginateTimeline(response, limit)
+
+	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
+	if err != nil {
+		return nil, err
+	}
+
+	getHomeTimelineResponse := &pb.GetAllPostsResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return getHomeTimelineResponse, nil
+}
+
+func (r *resource) RestorePost(ctx *context.Context, postId, userId string) error {
+	target, err := r.repositories.Mysql.GetTarget(ctx, models.MentionTargetPost, postId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.authorize(ctx, userId, authorization.ActionRestorePost, target)
+	if err != nil {
+		return err
+	}
+
+	restoreWindow := config.ParseDuration(config.POST_RESTORE_WINDOW, defaultPostRestoreWindow)
+	err = r.repositories.Mysql.RestorePost(ctx, postId, time.Now().Add(-restoreWindow))
+	if err != nil {
+		return err
+	}
+
+	return r.fanOutPost(ctx, postId)
+}
+
+func (r *resource) PurgeDeletedPosts(ctx *context.Context) (int64, error) {
+	restoreWindow := config.ParseDuration(config.POST_RESTORE_WINDOW, defaultPostRestoreWindow)
+	purged, err := r.repositories.Mysql.PurgeDeletedPosts(ctx, time.Now().Add(-restoreWindow), purgeDeletedPostsBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+func (r *resource) CreateDraft(ctx *context.Context, in *models.PostDraftParams) (*models.Post, error) {
+	err := requireCaller(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.checkDailyPostQuota(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	postStatus, err := draftStatusFromPublishAt(in.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := in.Visibility
+	if visibility == "" {
+		visibility = models.PostVisibilityPublic
+	}
+	err = validateVisibility(visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAttachments(in.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.validateQuotedPost(ctx, in.QuotedPostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	title, content, holdReason, err := r.moderatePost(in.Title, in.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	postId := uuid.New().String()
+	err = r.repositories.Mysql.CreatePost(ctx, in.UserId, postId, title, content, in.Type, in.UrlImagePost, visibility, postStatus, moderationStatusFromHoldReason(holdReason, models.ModerationStatusVisible), in.QuotedPostId, holdReason, in.PublishAt, in.Attachments, parser.ExtractHashtags(content), parser.ExtractMentions(content))
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := r.repositories.Mysql.GetDraft(ctx, postId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+// UpdateDraft edits a draft the caller owns. The schedule is kept unless
+// PublishAt or ClearPublishAt is set. Editing the text of a draft re-runs the
+// automatic filters over its full title and content, so a held draft whose
+// text is now clean becomes visible and leaves the moderation queue.
+func (r *resource) UpdateDraft(ctx *context.Context, in *models.PostDraftParams) (*pb.UpdatePostResponse, error) {
+	postStatus, err := draftStatusUpdate(in.PublishAt, in.ClearPublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Visibility != "" {
+		err = validateVisibility(in.Visibility)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = validateAttachments(in.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := r.getTarget(ctx, models.MentionTargetPost, in.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.authorize(ctx, in.UserId, authorization.ActionUpdateDraft, target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.validateQuotedPost(ctx, in.QuotedPostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	title, content, moderationStatus, holdReason := "", "", "", ""
+	if in.Title != "" || in.Content != "" {
+		draft, err := r.repositories.Mysql.GetDraft(ctx, in.PostId, in.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		if in.Title != "" {
+			draft.Title = in.Title
+		}
+		if in.Content != "" {
+			draft.Content = in.Content
+		}
+
+		moderatedTitle, moderatedContent, reason, err := r.moderatePost(draft.Title, draft.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		if in.Title != "" {
+			title = moderatedTitle
+		}
+		if in.Content != "" {
+			content = moderatedContent
+		}
+		holdReason = reason
+		moderationStatus = moderationStatusFromHoldReason(holdReason, models.ModerationStatusVisible)
+	}
+
+	err = r.repositories.Mysql.UpdateDraft(ctx, in.PostId, title, content, in.UrlImagePost, in.Visibility, in.QuotedPostId, postStatus, moderationStatus, in.PublishAt, in.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.holdForModeration(ctx, models.MentionTargetPost, in.PostId, in.PostId, holdReason)
+	if err != nil {
+		return nil, err
+	}
+
+	if content != "" {
+		err = r.syncPostContentReferences(ctx, in.PostId, in.UserId, content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	draft, err := r.repositories.Mysql.GetDraft(ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseModelsPost, err := transformer.GetPostToBaseModels(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	updateDraftResponse := &pb.UpdatePostResponse{
+		Post: baseModelsPost,
+	}
+
+	return updateDraftResponse, nil
+}
+
+func (r *resource) PublishDraft(ctx *context.Context, postId, userId string) error {
+	target, err := r.getTarget(ctx, models.MentionTargetPost, postId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.authorize(ctx, userId, authorization.ActionUpdateDraft, target)
+	if err != nil {
+		return err
+	}
+
+	err = r.repositories.Mysql.PublishDraft(ctx, postId)
+	if err != nil {
+		return err
+	}
+
+	return r.fanOutPost(ctx, postId)
+}
+
+func (r *resource) GetAllDraftsFromUser(ctx *context.Context, userId, cursor string, limit int64) (*pb.GetAllPostsFromUserResponse, error) {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	drafts, err := r.repositories.Mysql.GetAllDraftsFromUser(ctx, userId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, nextCursor := paginatePosts(drafts, limit)
+
+	baseModelsPosts, err := transformer.GetAllPostsFromUserToBaseModels(drafts)
+	if err != nil {
+		return nil, err
+	}
+
+	getAllDraftsFromUserResponse := &pb.GetAllPostsFromUserResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return getAllDraftsFromUserResponse, nil
+}
+
+func (r *resource) PublishScheduledPosts(ctx *context.Context) (int64, error) {
+	publishedPostIds, err := r.repositories.Mysql.PublishScheduledPosts(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, postId := range publishedPostIds {
+		err = r.fanOutPost(ctx, postId)
+		if err != nil {
+			log.Printf("fan out scheduled post %q failed: %v\n", postId, err)
+		}
+	}
+
+	return int64(len(publishedPostIds)), nil
+}
+
+func (r *resource) GetAllPostsFromTag(ctx *context.Context, tag, viewerId, cursor string, limit int64) (*pb.GetAllPostsResponse, error) {
+	tag = parser.NormalizeHashtag(tag)
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag is required")
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	response, err := r.repositories.Mysql.GetAllPostsFromTag(ctx, tag, viewerId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	response, nextCursor := paginatePosts(response, limit)
+
+	baseModelsPosts, err := transformer.GetAllPostsToBaseModels(response)
+	if err != nil {
+		return nil, err
+	}
+
+	getAllPostsFromTagResponse := &pb.GetAllPostsResponse{
+		Posts:      baseModelsPosts,
+		NextCursor: nextCursor,
+	}
+
+	return getAllPostsFromTagResponse, nil
+}
+
+func (r *resource) GetTrendingTags(ctx *context.Context, window time.Duration, limit int64) (*pb.GetTrendingTagsResponse, error) {
+	if window <= 0 {
+		window = defaultTrendingTagsWindow
+	}
+
+	tags, err := r.repositories.Mysql.GetTrendingTags(ctx, time.Now().Add(-window), pagination.NormalizeLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	baseModelsTags, err := transformer.GetAllTagCountsToBaseModels(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	getTrendingTagsResponse := &pb.GetTrendingTagsResponse{
+		Tags: baseModelsTags,
+	}
+
+	return getTrendingTagsResponse, nil
+}
+
+func (r *resource) GetAllMentionsFromUser(ctx *context.Context, userId, cursor string, limit int64) ([]*models.Mention, string, error) {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	mentions, err := r.repositories.Mysql.GetAllMentionsFromUser(ctx, userId, decodedCursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if int64(len(mentions)) > limit {
+		mentions = mentions[:limit]
+		lastMention := mentions[len(mentions)-1]
+		nextCursor = pagination.EncodeCursor(lastMention.CreatedAt, lastMention.TargetId)
+	}
+
+	return mentions, nextCursor, nil
+}
+
+func (r *resource) GetAllLikesFromPost(ctx *context.Context, in *pb.GetAllLikesFromPostRequest) (*pb.GetAllLikesFromPostResponse, *models.ReactionSummary, error) {
+	err := checkViewer(ctx, in.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allLikesFromPost, err := r.repositories.Mysql.GetAllLikesFromPost(ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseModelsAllLikesFromPost, err := transformer.GetAllLikesFromPostOrCommentToBaseModels(allLikesFromPost)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	getLikesFromPostResponse := &pb.GetAllLikesFromPostResponse{
+		LikesFromPost: baseModelsAllLikesFromPost,
+	}
+
+	return getLikesFromPostResponse, summarizeReactions(allLikesFromPost, in.UserId), nil
+}
+
+func (r *resource) UpdateLikesFromPostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest, reaction string) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error) {
+	err := r.checkLikeTarget(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reaction, err = normalizeReaction(reaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = r.repositories.Mysql.UpdateLikesFromPostOrComments(ctx, in.PostId, in.CommentId, in.UserId, in.Type, reaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.getLikesFromPostOrComment(ctx, in)
+}
+
+func (r *resource) LikePostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest, reaction string) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error) {
+	err := r.checkLikeTarget(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reaction, err = normalizeReaction(reaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = r.repositories.Mysql.AddLike(ctx, in.PostId, in.CommentId, in.UserId, in.Type, reaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.getLikesFromPostOrComment(ctx, in)
+}
+
+func (r *resource) UnlikePostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error) {
+	err := r.checkLikeTarget(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = r.repositories.Mysql.RemoveLike(ctx, in.PostId, in.CommentId, in.UserId, in.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r.getLikesFromPostOrComment(ctx, in)
+}
+
+func (r *resource) checkLikeTarget(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) error {
+	err := requireCaller(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	postId := in.PostId
+	if in.Type == "likeToComment" {
+		postId, err = r.getCommentPostId(ctx, in.CommentId, in.PostId)
+		if err != nil {
+			return err
+		}
+	} else if in.Type != "likeToPost" {
+		return status.Error(codes.InvalidArgument, "invalid like type")
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, postId, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *resource) getLikesFromPostOrComment(ctx *context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, *models.ReactionSummary, error) {
+	var err error
+	likesFromPostOrComment := new(libModels.PostLikes)
+	if in.Type == "likeToPost" {
+		likesFromPostOrComment, err = r.repositories.Mysql.GetAllLikesFromPost(ctx, in.PostId, in.UserId)
+	} else if in.Type == "likeToComment" {
+		likesFromPostOrComment, err = r.repositories.Mysql.GetAllLikesFromComment(ctx, in.CommentId, in.UserId)
+	} else {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid like type")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseModelsLikesFromPostOrComment, err := transformer.GetAllLikesFromPostOrCommentToBaseModels(likesFromPostOrComment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updateLikesFromPostOrCommentResponse := &pb.UpdateLikesFromPostOrCommentResponse{
+		LikesFromPostOrComment: baseModelsLikesFromPostOrComment,
+	}
+
+	return updateLikesFromPostOrCommentResponse, summarizeReactions(likesFromPostOrComment, in.UserId), nil
+}
+
+func (r *resource) CreateCommentOrReply(ctx *context.Context, in *pb.CreateCommentOrReplyRequest) (*pb.CreateCommentOrReplyResponse, error) {
+	err := requireCaller(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	postId := in.PostId
+	if in.Type == "reply" {
+		postId, err = r.getCommentPostId(ctx, in.ParentCommentId, in.PostId)
+		if err != nil {
+			return nil, err
+		}
+	} else if in.Type != "comment" {
+		return nil, status.Error(codes.InvalidArgument, "invalid comment type")
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, postId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	content, holdReason, err := r.moderateText(moderation.ContentKindComment, in.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetType, targetId string
+	if in.Type == "comment" {
+		targetType, targetId = models.MentionTargetComment, uuid.New().String()
+		err = r.repositories.Mysql.CreateComment(ctx, postId, targetId, in.UserId, content, moderationStatusFromHoldReason(holdReason, models.ModerationStatusVisible))
+	} else {
+		targetType, targetId = models.MentionTargetReply, uuid.New().String()
+		err = r.repositories.Mysql.CreateReply(ctx, in.ParentCommentId, targetId, in.UserId, content, moderationStatusFromHoldReason(holdReason, models.ModerationStatusVisible))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.holdForModeration(ctx, targetType, targetId, postId, holdReason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.repositories.Mysql.ReplaceMentions(ctx, targetType, targetId, postId, in.UserId, parser.ExtractMentions(content))
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := r.repositories.Mysql.GetAllCommentsFromPost(ctx, postId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseModelsComment, err := transformer.GetAllCommentsFromPostToBaseModels(comments)
+	if err != nil {
+		return nil, err
+	}
+
+	addCommentToPostResponse := &pb.CreateCommentOrReplyResponse{
+		CommentsFromPost: baseModelsComment,
+	}
+
+	return addCommentToPostResponse, nil
+}
+
+func (r *resource) DeleteCommentOrReply(ctx *context.Context, in *pb.DeleteCommentOrReplyRequest) error {
+	var targetType, targetId string
+	if in.Type == "comment" {
+		targetType, targetId = models.MentionTargetComment, in.CommentId
+	} else if in.Type == "reply" {
+		targetType, targetId = models.MentionTargetReply, in.ReplyId
+	} else {
+		return status.Error(codes.InvalidArgument, "invalid comment type")
+	}
+
+	target, err := r.getTarget(ctx, targetType, targetId)
+	if err != nil {
+		return err
+	}
+
+	subject, err := r.authorize(ctx, in.UserId, authorization.ActionDeleteComment, target)
+	if err != nil {
+		return err
+	}
+
+	moderationAction := staffDeleteAction(subject, target, targetType, targetId)
+	if targetType == models.MentionTargetComment {
+		return r.repositories.Mysql.DeleteComment(ctx, targetId, moderationAction)
+	}
+
+	return r.repositories.Mysql.DeleteReply(ctx, targetId, moderationAction)
+}
+
+func (r *resource) GetAllCommentsFromPost(ctx *context.Context, in *pb.GetAllCommentsFromPostRequest) (*pb.GetAllCommentsFromPostResponse, error) {
+	err := checkViewer(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.repositories.Mysql.GetPost(ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := r.repositories.Mysql.GetAllCommentsFromPost(ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseModelsComment, err := transformer.GetAllCommentsFromPostToBaseModels(comments)
+	if err != nil {
+		return nil, err
+	}
+
+	getAllCommentsFromPostResponse := &pb.GetAllCommentsFromPostResponse{
+		CommentsFromPost: baseModelsComment,
+	}
+
+	return getAllCommentsFromPostResponse, nil
+}
+
+func (r *resource) ReloadModerationRules() error {
+	return r.moderator.Reload()
+}
+
+func (r *resource) ReportContent(ctx *context.Context, in *models.ReportContentParams) error {
+	err := requireCaller(ctx, in.ReporterId)
+	if err != nil {
+		return err
+	}
+
+	err = validateReportCategory(in.Category)
+	if err != nil {
+		return err
+	}
+
+	if len(in.Details) > models.MaxReportDetailsLength {
+		return status.Error(codes.InvalidArgument, "report details are too long")
+	}
+
+	target, err := r.getTarget(ctx, in.TargetType, in.TargetId)
+	if err != nil {
+		return err
+	}
+
+	postId := target.PostId
+	_, err = r.repositories.Mysql.GetPost(ctx, postId, in.ReporterId)
+	if err != nil {
+		return err
+	}
+
+	created, err := r.repositories.Mysql.CreateReport(ctx, uuid.New().String(), in.TargetType, in.TargetId, postId, in.ReporterId, in.Category, in.Details)
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		return nil
+	}
+
+	reportsCount, err := r.repositories.Mysql.CountReports(ctx, in.TargetType, in.TargetId)
+	if err != nil {
+		return err
+	}
+
+	if reportsCount < config.ParseInt(config.REPORTS_HIDE_THRESHOLD, defaultReportsHideThreshold) {
+		return nil
+	}
+
+	hidden, err := r.repositories.Mysql.HideContent(ctx, in.TargetType, in.TargetId, postId)
+	if err != nil {
+		return err
+	}
+
+	if !hidden {
+		return nil
+	}
+
+	reason := fmt.Sprintf("reported by %d users", reportsCount)
+	return r.repositories.Mysql.EnqueueModerationItem(ctx, in.TargetType, in.TargetId, postId, models.ModerationSourceReports, reason)
+}
+
+func (r *resource) GetModerationQueue(ctx *context.Context, moderatorId, cursor string, limit int64) (*pb.GetModerationQueueResponse, error) {
+	_, err := r.authorize(ctx, moderatorId, authorization.ActionModerateContent, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit = pagination.NormalizeLimit(limit)
+	items, err := r.repositories.Mysql.GetModerationQueue(ctx, decodedCursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if int64(len(items)) > limit {
+		items = items[:limit]
+		lastItem := items[len(items)-1]
+		nextCursor = pagination.EncodeCursor(lastItem.CreatedAt, lastItem.TargetId)
+	}
+
+	baseModelsItems, err := transformer.GetModerationQueueToBaseModels(items)
+	if err != nil {
+		return nil, err
+	}
+
+	getModerationQueueResponse := &pb.GetModerationQueueResponse{
+		Items:      baseModelsItems,
+		NextCursor: nextCursor,
+	}
+
+	return getModerationQueueResponse, nil
+}
+
+func (r *resource) ApproveContent(ctx *context.Context, in *models.ModerationActionParams) error {
+	return r.applyModerationAction(ctx, in, models.ModerationActionApprove, models.ModerationStatusVisible, models.ModerationItemApproved)
+}
+
+func (r *resource) RemoveContent(ctx *context.Context, in *models.ModerationActionParams) error {
+	if strings.TrimSpace(in.Reason) == "" {
+		return status.Error(codes.InvalidArgument, "a reason is required to remove content")
+	}
+
+	return r.applyModerationAction(ctx, in, models.ModerationActionRemove, models.ModerationStatusRemoved, models.ModerationItemRemoved)
+}
+
+func (r *resource) RestoreContent(ctx *context.Context, in *models.ModerationActionParams) error {
+	return r.applyModerationAction(ctx, in, models.ModerationActionRestore, models.ModerationStatusVisible, models.ModerationItemApproved)
+}
+
+func (r *resource) applyModerationAction(ctx *context.Context, in *models.ModerationActionParams, action, moderationStatus, queueStatus string) error {
+	_, err := r.authorize(ctx, in.ModeratorId, authorization.ActionModerateContent, nil)
+	if err != nil {
+		return err
+	}
+
+	target, err := r.getTarget(ctx, in.TargetType, in.TargetId)
+	if err != nil {
+		return err
+	}
+
+	return r.repositories.Mysql.ApplyModerationAction(ctx, uuid.New().String(), in.ModeratorId, action, in.TargetType, in.TargetId, target.PostId, moderationStatus, queueStatus, in.Reason)
+}
+
+// getCommentPostId returns the post of commentId, which may name either a
+// comment or a reply. A non-empty postId must match that post.
+func (r *resource) getCommentPostId(ctx *context.Context, commentId, postId string) (string, error) {
+	target, err := r.getTarget(ctx, models.MentionTargetComment, commentId)
+	if status.Code(err) == codes.NotFound {
+		target, err = r.getTarget(ctx, models.MentionTargetReply, commentId)
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", status.Error(codes.NotFound, "comment not found")
+		}
+		return "", err
+	}
+
+	if postId != "" && postId != target.PostId {
+		return "", status.Error(codes.InvalidArgument, "comment does not belong to post")
+	}
+
+	return target.PostId, nil
+}
+
+// getTarget resolves a post, comment or reply, treating content on soft
+// deleted posts as missing.
+func (r *resource) getTarget(ctx *context.Context, targetType, targetId string) (*models.ContentTarget, error) {
+	target, err := r.repositories.Mysql.GetTarget(ctx, targetType, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.PostDeleted {
+		return nil, status.Error(codes.NotFound, targetType+" not found")
+	}
+
+	return target, nil
+}
+
+// authorize checks that userId is the authenticated caller, loads its roles and checks them against the policies
+// of action. target is nil for actions that are not tied to any content.
+func (r *resource) authorize(ctx *context.Context, userId string, action authorization.Action, target *models.ContentTarget) (authorization.Subject, error) {
+	err := requireCaller(ctx, userId)
+	if err != nil {
+		return authorization.Subject{}, err
+	}
+
+	roles, err := r.repositories.Mysql.GetUserRoles(ctx, userId)
+	if err != nil {
+		return authorization.Subject{}, err
+	}
+
+	subject := authorization.NewSubject(userId, roles)
+	var targetResource authorization.Resource
+	if target != nil {
+		targetResource.OwnerId = target.OwnerId
+		targetResource.PostAuthorId = target.PostAuthorId
+	}
+
+	return subject, r.authorizer.Authorize(subject, action, targetResource)
+}
+
+// moderateText runs the moderation rules over text and returns the text to
+// store, which may be masked, and the reason when it must be held for review.
+func (r *resource) moderateText(kind moderation.ContentKind, text string) (string, string, error) {
+	if text == "" {
+		return "", "", nil
+	}
+
+	result := r.moderator.Moderate(kind, text)
+	switch result.Action {
+	case moderation.ActionReject:
+		return "", "", status.Error(codes.InvalidArgument, result.Reason)
+	case moderation.ActionHold:
+		return result.Text, result.Reason, nil
+	}
+
+	return result.Text, "", nil
+}
+
+func (r *resource) moderatePost(title, content string) (string, string, string, error) {
+	moderatedTitle, titleHoldReason, err := r.moderateText(moderation.ContentKindTitle, title)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	moderatedContent, holdReason, err := r.moderateText(moderation.ContentKindPost, content)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if holdReason == "" {
+		holdReason = titleHoldReason
+	}
+
+	return moderatedTitle, moderatedContent, holdReason, nil
+}
+
+func (r *resource) holdForModeration(ctx *context.Context, targetType, targetId, postId, holdReason string) error {
+	if holdReason == "" {
+		return nil
+	}
+
+	return r.repositories.Mysql.EnqueueModerationItem(ctx, targetType, targetId, postId, models.ModerationSourceAutomatic, holdReason)
+}
+
+// staffDeleteAction returns the audit entry for staff deleting someone
+// else's content, or nil when users delete their own.
+func staffDeleteAction(subject authorization.Subject, target *models.ContentTarget, targetType, targetId string) *models.ModerationAction {
+	if !subject.IsStaff() || target.OwnerId == subject.UserId {
+		return nil
+	}
+
+	return &models.ModerationAction{
+		ActionId:    uuid.New().String(),
+		ModeratorId: subject.UserId,
+		Action:      models.ModerationActionDelete,
+		TargetType:  targetType,
+		TargetId:    targetId,
+		PostId:      target.PostId,
+	}
+}
+
+// moderationStatusFromHoldReason returns fallback for content that was not
+// held, so updates can pass "" to keep the status a post already has.
+func moderationStatusFromHoldReason(holdReason, fallback string) string {
+	if holdReason == "" {
+		return fallback
+	}
+
+	return models.ModerationStatusHeld
+}
+
+// fanOutPost copies postId into the followers' timelines in the background.
+// Timelines are read with the post's current visibility and moderation
+// status, so entries are written for every follower. When the queue stays
+// full the post is read by pull instead, so no follower misses it.
+func (r *resource) fanOutPost(ctx *context.Context, postId string) error {
+	maxFollowers := config.ParseInt(config.TIMELINE_FANOUT_MAX_FOLLOWERS, defaultFanOutMaxFollowers)
+	err := r.submitTimelineTask(ctx, workerpool.Task{
+		Name: "fan out post " + postId,
+		Run: func(ctx *context.Context) error {
+			_, err := r.repositories.Mysql.FanOutPost(ctx, postId, maxFollowers)
+			return err
+		},
+	})
+	if err != nil {
+		return r.repositories.Mysql.AddTimelinePullPost(ctx, postId)
+	}
+
+	return nil
+}
+
+// fanOutRepost copies the repost of postId by userId into the timelines of
+// userId's followers in the background, or right away when the queue stays
+// full.
+func (r *resource) fanOutRepost(ctx *context.Context, userId, postId string) error {
+	maxFollowers := config.ParseInt(config.TIMELINE_FANOUT_MAX_FOLLOWERS, defaultFanOutMaxFollowers)
+	err := r.submitTimelineTask(ctx, workerpool.Task{
+		Name: "fan out repost of " + postId + " by " + userId,
+		Run: func(ctx *context.Context) error {
+			_, err := r.repositories.Mysql.FanOutRepost(ctx, userId, postId, maxFollowers)
+			return err
+		},
+	})
+	if err != nil {
+		_, err = r.repositories.Mysql.FanOutRepost(ctx, userId, postId, maxFollowers)
+		return err
+	}
+
+	return nil
+}
+
+// removeFromTimelines drops the entries of a deleted post in the background.
+// The entries are harmless if it cannot be queued: timelines skip deleted
+// posts and the purge job removes them with the post.
+func (r *resource) removeFromTimelines(ctx *context.Context, postId string) {
+	_ = r.submitTimelineTask(ctx, workerpool.Task{
+		Name: "remove post " + postId + " from timelines",
+		Run: func(ctx *context.Context) error {
+			return r.repositories.Mysql.DeleteTimelineEntries(ctx, postId)
+		},
+	})
+}
+
+// backfillTimeline copies the recent posts of authorId into the timeline of
+// userId in the background, or right away when the queue stays full.
+func (r *resource) backfillTimeline(ctx *context.Context, userId, authorId string) error {
+	backfill := func(ctx *context.Context) error {
+		_, err := r.repositories.Mysql.BackfillTimeline(ctx, userId, authorId, timelineBackfillLimit)
+		return err
+	}
+
+	err := r.submitTimelineTask(ctx, workerpool.Task{
+		Name: "backfill timeline of " + userId + " with " + authorId,
+		Run:  backfill,
+	})
+	if err != nil {
+		return backfill(ctx)
+	}
+
+	return nil
+}
+
+// submitTimelineTask waits up to timelineSubmitTimeout for room in the
+// timelines queue.
+func (r *resource) submitTimelineTask(ctx *context.Context, task workerpool.Task) error {
+	submitCtx, cancel := context.WithTimeout(*ctx, timelineSubmitTimeout)
+	defer cancel()
+
+	return r.timelines.Submit(submitCtx, task)
+}
+
+func (r *resource) syncPostContentReferences(ctx *context.Context, postId, authorId, content string) error {
+	err := r.repositories.Mysql.ReplacePostTags(ctx, postId, parser.ExtractHashtags(content))
+	if err != nil {
+		return err
+	}
+
+	err = r.repositories.Mysql.ReplaceMentions(ctx, models.MentionTargetPost, postId, postId, authorId, parser.ExtractMentions(content))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQuotedPost checks that a post being quoted exists and is visible
+// to the quoting user.
+func (r *resource) validateQuotedPost(ctx *context.Context, quotedPostId, userId string) error {
+	if quotedPostId == "" {
+		return nil
+	}
+
+	_, err := r.repositories.Mysql.GetPost(ctx, quotedPostId, userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *resource) checkDailyPostQuota(ctx *context.Context, userId string) error {
+	quota := config.ParseInt(config.DAILY_POST_QUOTA, defaultDailyPostQuota)
+	retryAfter, err := r.repositories.Mysql.GetPostsQuotaRetryAfter(ctx, userId, time.Now().Add(-dailyPostQuotaWindow), quota)
+	if err != nil {
+		return err
+	}
+
+	if retryAfter > 0 {
+		return ratelimit.Exhausted(*ctx, retryAfter, "daily post quota exceeded")
+	}
+
+	return nil
+}
+
+// requireCaller rejects anonymous requests and requests whose user id differs
+// from the authenticated caller.
+func requireCaller(ctx *context.Context, userId string) error {
+	callerId := authentication.UserIdFromContext(*ctx)
+	if callerId == "" {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if userId != callerId {
+		return status.Error(codes.PermissionDenied, "user id does not match the authenticated user")
+	}
+
+	return nil
+}
+
+// checkViewer allows anonymous reads but, like requireCaller, rejects a
+// viewer id that differs from the authenticated caller.
+func checkViewer(ctx *context.Context, viewerId string) error {
+	if viewerId == "" {
+		return nil
+	}
+
+	return requireCaller(ctx, viewerId)
+}
+
+func validateReportCategory(category string) error {
+	switch category {
+	case models.ReportCategorySpam, models.ReportCategoryHarassment, models.ReportCategoryHateSpeech, models.ReportCategoryViolence,
+		models.ReportCategoryNudity, models.ReportCategoryMisinformation, models.ReportCategoryOther:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "invalid report category")
+	}
+}
+
+// normalizeReaction defaults an empty reaction to a like.
+func normalizeReaction(reaction string) (string, error) {
+	switch reaction {
+	case "":
+		return models.ReactionLike, nil
+	case models.ReactionLike, models.ReactionLove, models.ReactionLaugh, models.ReactionWow, models.ReactionSad, models.ReactionInsightful:
+		return reaction, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid reaction")
+	}
+}
+
+func summarizeReactions(likes *libModels.PostLikes, viewerId string) *models.ReactionSummary {
+	summary := &models.ReactionSummary{
+		Counts: make(map[string]int64),
+	}
+
+	for _, like := range likes.Likes {
+		summary.Counts[like.Type]++
+		if viewerId != "" && like.UserId == viewerId {
+			summary.ViewerReaction = like.Type
+		}
+	}
+
+	return summary
+}
+
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case models.PostVisibilityPublic, models.PostVisibilityUnlisted, models.PostVisibilityPrivate, models.PostVisibilityFollowers:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "invalid post visibility")
+	}
+}
+
+func validateAttachments(attachments []models.PostAttachment) error {
+	if len(attachments) > models.MaxAttachmentsPerPost {
+		return status.Errorf(codes.InvalidArgument, "a post can have at most %d attachments", models.MaxAttachmentsPerPost)
+	}
+
+	for _, attachment := range attachments {
+		switch attachment.Kind {
+		case models.AttachmentKindImage, models.AttachmentKindVideo, models.AttachmentKindDocument:
+		default:
+			return status.Error(codes.InvalidArgument, "invalid attachment kind")
+		}
+
+		if attachment.Url == "" {
+			return status.Error(codes.InvalidArgument, "attachment url is required")
+		}
+
+		if attachment.Width < 0 || attachment.Height < 0 {
+			return status.Error(codes.InvalidArgument, "attachment dimensions must not be negative")
+		}
+	}
+
+	return nil
+}
+
+func draftStatusFromPublishAt(publishAt *time.Time) (string, error) {
+	if publishAt == nil {
+		return models.PostStatusDraft, nil
+	}
+
+	if !publishAt.After(time.Now()) {
+		return "", status.Error(codes.InvalidArgument, "publishAt must be in the future")
+	}
+
+	return models.PostStatusScheduled, nil
+}
+
+// draftStatusUpdate returns the status a draft edit moves the draft to, or
+// an empty string to keep its current status and publishAt.
+func draftStatusUpdate(publishAt *time.Time, clearPublishAt bool) (string, error) {
+	if clearPublishAt {
+		if publishAt != nil {
+			return "", status.Error(codes.InvalidArgument, "publishAt cannot be set and cleared at once")
+		}
+
+		return models.PostStatusDraft, nil
+	}
+
+	if publishAt == nil {
+		return "", nil
+	}
+
+	return draftStatusFromPublishAt(publishAt)
+}
+
+func paginatePosts(posts []*models.Post, limit int64) ([]*models.Post, string) {
+	if int64(len(posts)) <= limit {
+		return posts, ""
+	}
+
+	posts = posts[:limit]
+	lastPost := posts[len(posts)-1]
+
+	return posts, pagination.EncodeCursor(lastPost.CreatedAt, lastPost.PostId)
+}
+
+// paginateTimeline is paginatePosts for the home timeline, where reposts are
+// placed at the time they were reposted.
+func paginateTimeline(posts []*models.Post, limit int64) ([]*models.Post, string) {
+	if int64(len(posts)) <= limit {
+		return posts, ""
+	}
+
+	posts = posts[:limit]
+	lastPost := posts[len(posts)-1]
+	timelineAt := lastPost.CreatedAt
+	if lastPost.RepostedBy != "" {
+		timelineAt = lastPost.RepostedAt
+	}
+
+	return posts, pagination.EncodeCursor(timelineAt, lastPost.PostId)
 }
 
-func NewPostHandler(repositories *repositories.Repositories) IPostHandler {
+func NewPostHandler(repositories *repositories.Repositories, moderator *moderation.Moderator, timelines *workerpool.Pool) IPostHandler {
 	return &resource{
 		repositories: repositories,
+		moderator:    moderator,
+		authorizer:   authorization.NewAuthorizer(),
+		timelines:    timelines,
 	}
 }
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDraftStatusUpdate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		publishAt      *time.Time
+		clearPublishAt bool
+		want           string
+		wantCode       codes.Code
+	}{
+		{"keep schedule", nil, false, "", codes.OK},
+		{"schedule", &future, false, models.PostStatusScheduled, codes.OK},
+		{"unschedule", nil, true, models.PostStatusDraft, codes.OK},
+		{"past publishAt", &past, false, "", codes.InvalidArgument},
+		{"set and clear", &future, true, "", codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := draftStatusUpdate(tt.publishAt, tt.clearPublishAt)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("draftStatusUpdate() error = %v, want code %v", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("draftStatusUpdate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginateTimeline(t *testing.T) {
+	post := &models.Post{Post: libModels.Post{PostId: "post-1", CreatedAt: "2026-01-01T10:00:00Z"}}
+	repost := &models.Post{
+		Post:       libModels.Post{PostId: "post-2", CreatedAt: "2026-01-01T08:00:00Z"},
+		RepostedBy: "user-2",
+		RepostedAt: "2026-01-01T09:00:00Z",
+	}
+
+	tests := []struct {
+		name       string
+		posts      []*models.Post
+		limit      int64
+		wantLen    int
+		wantCursor string
+	}{
+		{"last page", []*models.Post{post, repost}, 2, 2, ""},
+		{"post ends the page", []*models.Post{post, repost}, 1, 1, pagination.EncodeCursor(post.CreatedAt, post.PostId)},
+		{"repost ends the page", []*models.Post{post, repost, post}, 2, 2, pagination.EncodeCursor(repost.RepostedAt, repost.PostId)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts, cursor := paginateTimeline(tt.posts, tt.limit)
+			if len(posts) != tt.wantLen || cursor != tt.wantCursor {
+				t.Errorf("paginateTimeline() = %d posts, %q, want %d posts, %q", len(posts), cursor, tt.wantLen, tt.wantCursor)
+			}
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package handler
 
-import "github.com/relaunch-cot/service-post/repositories"
+import (
+	"github.com/relaunch-cot/service-post/repositories"
+	"github.com/relaunch-cot/service-post/resource/moderation"
+	"github.com/relaunch-cot/service-post/resource/workerpool"
+)
 
 type Handlers struct {
 	Post IPostHandler
 }
 
-func (h *Handlers) Inject(repositories *repositories.Repositories) {
-	h.Post = NewPostHandler(repositories)
+func (h *Handlers) Inject(repositories *repositories.Repositories, moderator *moderation.Moderator, timelines *workerpool.Pool) {
+	h.Post = NewPostHandler(repositories, moderator, timelines)
 }
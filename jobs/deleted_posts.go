@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/relaunch-cot/service-post/handler"
+)
+
+func purgeDeletedPosts(ctx *context.Context, handler *handler.Handlers) error {
+	purged, err := handler.Post.PurgeDeletedPosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		log.Printf("purged %d deleted posts\n", purged)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/handler"
+)
+
+func Start(handler *handler.Handlers) {
+	go runPeriodically("publish scheduled posts", config.ParseDuration(config.SCHEDULED_POSTS_INTERVAL, time.Minute), func(ctx *context.Context) error {
+		return publishScheduledPosts(ctx, handler)
+	})
+	go runPeriodically("purge deleted posts", config.ParseDuration(config.PURGE_DELETED_POSTS_INTERVAL, time.Hour), func(ctx *context.Context) error {
+		return purgeDeletedPosts(ctx, handler)
+	})
+	go runPeriodically("reload moderation rules", config.ParseDuration(config.MODERATION_RELOAD_INTERVAL, time.Minute), func(ctx *context.Context) error {
+		return handler.Post.ReloadModerationRules()
+	})
+	go runPeriodically("recompute trending scores", config.ParseDuration(config.TRENDING_SCORES_INTERVAL, 5*time.Minute), func(ctx *context.Context) error {
+		return recomputeTrendingScores(ctx, handler)
+	})
+	go runPeriodically("reconcile post counters", config.ParseDuration(config.POST_COUNTERS_INTERVAL, time.Hour), func(ctx *context.Context) error {
+		return reconcilePostCounters(ctx, handler)
+	})
+}
+
+func runPeriodically(name string, interval time.Duration, job func(ctx *context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+		err := job(&ctx)
+		if err != nil {
+			log.Printf("job %q failed: %v\n", name, err)
+		}
+	}
+}
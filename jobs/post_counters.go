@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/relaunch-cot/service-post/handler"
+)
+
+func reconcilePostCounters(ctx *context.Context, handler *handler.Handlers) error {
+	reconciled, err := handler.Post.ReconcilePostCounters(ctx)
+	if err != nil {
+		return err
+	}
+
+	if reconciled > 0 {
+		log.Printf("reconciled engagement counters of %d posts\n", reconciled)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/relaunch-cot/service-post/handler"
+)
+
+func publishScheduledPosts(ctx *context.Context, handler *handler.Handlers) error {
+	published, err := handler.Post.PublishScheduledPosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	if published > 0 {
+		log.Printf("published %d scheduled posts\n", published)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/relaunch-cot/service-post/handler"
+)
+
+func recomputeTrendingScores(ctx *context.Context, handler *handler.Handlers) error {
+	scored, err := handler.Post.RecomputeTrendingScores(ctx)
+	if err != nil {
+		return err
+	}
+
+	if scored > 0 {
+		log.Printf("recomputed trending scores for %d posts\n", scored)
+	}
+
+	return nil
+}
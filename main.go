@@ -6,13 +6,16 @@ import (
 	"net"
 
 	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/jobs"
 	"github.com/relaunch-cot/service-post/resource"
+	"github.com/relaunch-cot/service-post/server"
 	"github.com/relaunch-cot/service-post/server/methods"
 	"google.golang.org/grpc"
 )
 
 func main() {
 	resource.Inject()
+	jobs.Start(&resource.Handler)
 
 	lis, err := net.Listen("tcp", ":"+config.PORT)
 	fmt.Println("Listening on " + config.PORT)
@@ -20,7 +23,10 @@ func main() {
 		log.Fatalf("Failed to listen on %v: %v\n", config.PORT, err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		server.AuthenticationInterceptor(resource.TokenVerifier),
+		server.RateLimitInterceptor(resource.RateLimiter),
+	))
 
 	methods.RegisterGrpcServices(s)
 
@@ -0,0 +1,2 @@
+CREATE INDEX idx_posts_created_at_post_id ON posts (createdAt, postId);
+CREATE INDEX idx_posts_author_created_at_post_id ON posts (authorId, createdAt, postId);
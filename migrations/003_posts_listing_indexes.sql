@@ -0,0 +1,3 @@
+CREATE INDEX idx_posts_type_created_at ON posts (type, createdAt);
+CREATE INDEX idx_likes_post_id ON likes (postId);
+CREATE INDEX idx_comments_post_id ON comments (postId);
@@ -0,0 +1,5 @@
+ALTER TABLE posts
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'published',
+    ADD COLUMN publishAt DATETIME NULL;
+
+CREATE INDEX idx_posts_status_publish_at ON posts (status, publishAt);
@@ -0,0 +1,11 @@
+CREATE TABLE post_revisions (
+    postId         VARCHAR(36)  NOT NULL,
+    revisionNumber BIGINT       NOT NULL,
+    title          VARCHAR(255) NOT NULL,
+    content        TEXT         NOT NULL,
+    urlImagePost   VARCHAR(2048) NULL,
+    editedBy       VARCHAR(36)  NOT NULL,
+    createdAt      DATETIME     NOT NULL,
+    PRIMARY KEY (postId, revisionNumber),
+    CONSTRAINT fk_post_revisions_post FOREIGN KEY (postId) REFERENCES posts (postId) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN deletedAt DATETIME NULL;
+
+CREATE INDEX idx_posts_deleted_at ON posts (deletedAt);
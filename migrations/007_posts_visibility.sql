@@ -0,0 +1,9 @@
+ALTER TABLE posts ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'public';
+
+CREATE TABLE IF NOT EXISTS follows (
+    followerId  VARCHAR(36) NOT NULL,
+    followingId VARCHAR(36) NOT NULL,
+    createdAt   DATETIME    NOT NULL,
+    PRIMARY KEY (followerId, followingId),
+    INDEX idx_follows_following_id (followingId)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE post_tags (
+    postId    VARCHAR(36) NOT NULL,
+    tag       VARCHAR(64) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (postId, tag),
+    INDEX idx_post_tags_tag (tag),
+    CONSTRAINT fk_post_tags_post FOREIGN KEY (postId) REFERENCES posts (postId) ON DELETE CASCADE
+);
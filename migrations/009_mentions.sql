@@ -0,0 +1,11 @@
+CREATE TABLE mentions (
+    targetType      VARCHAR(16) NOT NULL,
+    targetId        VARCHAR(36) NOT NULL,
+    postId          VARCHAR(36) NOT NULL,
+    mentionedUserId VARCHAR(36) NOT NULL,
+    authorId        VARCHAR(36) NOT NULL,
+    createdAt       DATETIME    NOT NULL,
+    PRIMARY KEY (targetType, targetId, mentionedUserId),
+    INDEX idx_mentions_mentioned_user (mentionedUserId, createdAt, targetId),
+    INDEX idx_mentions_post_id (postId)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE post_attachments (
+    postId   VARCHAR(36)   NOT NULL,
+    position INT           NOT NULL,
+    kind     VARCHAR(16)   NOT NULL,
+    url      VARCHAR(2048) NOT NULL,
+    mimeType VARCHAR(255)  NOT NULL DEFAULT '',
+    width    INT           NOT NULL DEFAULT 0,
+    height   INT           NOT NULL DEFAULT 0,
+    altText  VARCHAR(1024) NOT NULL DEFAULT '',
+    PRIMARY KEY (postId, position),
+    CONSTRAINT fk_post_attachments_post FOREIGN KEY (postId) REFERENCES posts (postId) ON DELETE CASCADE
+);
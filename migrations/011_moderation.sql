@@ -0,0 +1,17 @@
+ALTER TABLE posts ADD COLUMN moderationStatus VARCHAR(16) NOT NULL DEFAULT 'visible';
+ALTER TABLE comments ADD COLUMN moderationStatus VARCHAR(16) NOT NULL DEFAULT 'visible';
+ALTER TABLE comment_replies ADD COLUMN moderationStatus VARCHAR(16) NOT NULL DEFAULT 'visible';
+
+CREATE TABLE moderation_queue (
+    targetType VARCHAR(16)   NOT NULL,
+    targetId   VARCHAR(36)   NOT NULL,
+    postId     VARCHAR(36)   NOT NULL,
+    source     VARCHAR(16)   NOT NULL,
+    reason     VARCHAR(1024) NOT NULL DEFAULT '',
+    status     VARCHAR(16)   NOT NULL,
+    createdAt  DATETIME      NOT NULL,
+    resolvedBy VARCHAR(36)   NULL,
+    resolvedAt DATETIME      NULL,
+    PRIMARY KEY (targetType, targetId),
+    INDEX idx_moderation_queue_status_created_at (status, createdAt)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE reports (
+    reportId   VARCHAR(36)   NOT NULL,
+    targetType VARCHAR(16)   NOT NULL,
+    targetId   VARCHAR(36)   NOT NULL,
+    postId     VARCHAR(36)   NOT NULL,
+    reporterId VARCHAR(36)   NOT NULL,
+    category   VARCHAR(32)   NOT NULL,
+    details    VARCHAR(1000) NOT NULL DEFAULT '',
+    createdAt  DATETIME      NOT NULL,
+    PRIMARY KEY (reportId),
+    UNIQUE INDEX idx_reports_target_reporter (targetType, targetId, reporterId),
+    INDEX idx_reports_post_id (postId)
+);
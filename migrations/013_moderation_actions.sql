@@ -0,0 +1,19 @@
+CREATE TABLE moderators (
+    userId    VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (userId)
+);
+
+CREATE TABLE moderation_actions (
+    actionId    VARCHAR(36)   NOT NULL,
+    moderatorId VARCHAR(36)   NOT NULL,
+    action      VARCHAR(16)   NOT NULL,
+    targetType  VARCHAR(16)   NOT NULL,
+    targetId    VARCHAR(36)   NOT NULL,
+    postId      VARCHAR(36)   NOT NULL,
+    reason      VARCHAR(1024) NOT NULL DEFAULT '',
+    createdAt   DATETIME      NOT NULL,
+    PRIMARY KEY (actionId),
+    INDEX idx_moderation_actions_moderator_created_at (moderatorId, createdAt),
+    INDEX idx_moderation_actions_target (targetType, targetId)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE user_roles (
+    userId    VARCHAR(36) NOT NULL,
+    role      VARCHAR(16) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (userId, role)
+);
+
+INSERT INTO user_roles (userId, role, createdAt)
+SELECT userId, 'moderator', createdAt FROM moderators;
+
+DROP TABLE moderators;
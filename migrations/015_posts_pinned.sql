@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN pinnedAt DATETIME NULL;
+
+CREATE INDEX idx_posts_author_pinned_at ON posts (authorId, pinnedAt);
@@ -0,0 +1,12 @@
+CREATE TABLE reposts (
+    userId    VARCHAR(36) NOT NULL,
+    postId    VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (userId, postId),
+    INDEX idx_reposts_post_id (postId),
+    INDEX idx_reposts_user_created_at (userId, createdAt)
+);
+
+ALTER TABLE posts ADD COLUMN quotedPostId VARCHAR(36) NULL;
+
+CREATE INDEX idx_posts_quoted_post_id ON posts (quotedPostId);
@@ -0,0 +1,8 @@
+CREATE TABLE bookmarks (
+    userId    VARCHAR(36) NOT NULL,
+    postId    VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (userId, postId),
+    INDEX idx_bookmarks_post_id (postId),
+    INDEX idx_bookmarks_user_created_at (userId, createdAt)
+);
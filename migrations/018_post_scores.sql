@@ -0,0 +1,7 @@
+CREATE TABLE post_scores (
+    postId     VARCHAR(36) NOT NULL,
+    score      DOUBLE      NOT NULL,
+    computedAt DATETIME    NOT NULL,
+    PRIMARY KEY (postId),
+    INDEX idx_post_scores_score (score)
+);
@@ -0,0 +1,16 @@
+CREATE TABLE timeline_entries (
+    userId    VARCHAR(36) NOT NULL,
+    postId    VARCHAR(36) NOT NULL,
+    authorId  VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (userId, postId),
+    INDEX idx_timeline_entries_user_created_at (userId, createdAt, postId),
+    INDEX idx_timeline_entries_user_author (userId, authorId),
+    INDEX idx_timeline_entries_post_id (postId)
+);
+
+CREATE TABLE timeline_pull_authors (
+    authorId  VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (authorId)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE likes ADD COLUMN type VARCHAR(16) NOT NULL DEFAULT 'like';
+ALTER TABLE comment_likes ADD COLUMN type VARCHAR(16) NOT NULL DEFAULT 'like';
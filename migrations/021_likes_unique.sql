@@ -0,0 +1,26 @@
+-- Keep a single like per user and target, then enforce it with unique keys.
+CREATE TABLE likes_deduplicated LIKE likes;
+
+INSERT INTO likes_deduplicated (userId, postId, userName, type, likedAt)
+SELECT userId, postId, MIN(userName), MIN(type), MIN(likedAt)
+FROM likes
+GROUP BY userId, postId;
+
+ALTER TABLE likes_deduplicated ADD UNIQUE INDEX uq_likes_user_post (userId, postId);
+
+RENAME TABLE likes TO likes_duplicated, likes_deduplicated TO likes;
+DROP TABLE likes_duplicated;
+
+CREATE TABLE comment_likes_deduplicated LIKE comment_likes;
+
+INSERT INTO comment_likes_deduplicated (userId, commentId, replyId, userName, type, likedAt)
+SELECT userId, commentId, replyId, MIN(userName), MIN(type), MIN(likedAt)
+FROM comment_likes
+GROUP BY userId, commentId, replyId;
+
+ALTER TABLE comment_likes_deduplicated
+    ADD UNIQUE INDEX uq_comment_likes_user_comment (userId, commentId),
+    ADD UNIQUE INDEX uq_comment_likes_user_reply (userId, replyId);
+
+RENAME TABLE comment_likes TO comment_likes_duplicated, comment_likes_deduplicated TO comment_likes;
+DROP TABLE comment_likes_duplicated;
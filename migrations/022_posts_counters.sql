@@ -0,0 +1,37 @@
+ALTER TABLE posts
+    ADD COLUMN likesCount    BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN commentsCount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN repliesCount  BIGINT NOT NULL DEFAULT 0;
+
+UPDATE posts p
+    JOIN (
+        SELECT l.postId, COUNT(*) AS likesCount
+        FROM likes l
+        GROUP BY l.postId
+    ) l ON l.postId = p.postId
+SET p.likesCount = l.likesCount;
+
+UPDATE posts p
+    JOIN (
+        SELECT c.postId, COUNT(*) AS commentsCount
+        FROM comments c
+        GROUP BY c.postId
+    ) c ON c.postId = p.postId
+SET p.commentsCount = c.commentsCount;
+
+WITH RECURSIVE post_replies AS (
+    SELECT c.postId, cr.replyId
+    FROM comment_replies cr
+        JOIN comments c ON cr.commentId = c.commentId
+    UNION ALL
+    SELECT r.postId, cr.replyId
+    FROM comment_replies cr
+        JOIN post_replies r ON cr.parentReplyId = r.replyId
+)
+UPDATE posts p
+    JOIN (
+        SELECT pr.postId, COUNT(*) AS repliesCount
+        FROM post_replies pr
+        GROUP BY pr.postId
+    ) r ON r.postId = p.postId
+SET p.repliesCount = r.repliesCount;
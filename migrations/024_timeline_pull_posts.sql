@@ -0,0 +1,7 @@
+CREATE TABLE timeline_pull_posts (
+    postId    VARCHAR(36) NOT NULL,
+    authorId  VARCHAR(36) NOT NULL,
+    createdAt DATETIME    NOT NULL,
+    PRIMARY KEY (postId),
+    INDEX idx_timeline_pull_posts_author_created_at (authorId, createdAt, postId)
+);
@@ -0,0 +1,2 @@
+CREATE INDEX idx_posts_likes_count ON posts (likesCount, createdAt, postId);
+CREATE INDEX idx_posts_comments_count ON posts (commentsCount, createdAt, postId);
@@ -0,0 +1,27 @@
+UPDATE posts p
+    LEFT JOIN (
+        SELECT c.postId, COUNT(*) AS commentsCount
+        FROM comments c
+        WHERE c.moderationStatus = 'visible'
+        GROUP BY c.postId
+    ) c ON c.postId = p.postId
+SET p.commentsCount = IFNULL(c.commentsCount, 0);
+
+WITH RECURSIVE post_replies AS (
+    SELECT c.postId, cr.replyId
+    FROM comment_replies cr
+        JOIN comments c ON cr.commentId = c.commentId
+    WHERE c.moderationStatus = 'visible' AND cr.moderationStatus = 'visible'
+    UNION ALL
+    SELECT r.postId, cr.replyId
+    FROM comment_replies cr
+        JOIN post_replies r ON cr.parentReplyId = r.replyId
+    WHERE cr.moderationStatus = 'visible'
+)
+UPDATE posts p
+    LEFT JOIN (
+        SELECT pr.postId, COUNT(*) AS repliesCount
+        FROM post_replies pr
+        GROUP BY pr.postId
+    ) r ON r.postId = p.postId
+SET p.repliesCount = IFNULL(r.repliesCount, 0);
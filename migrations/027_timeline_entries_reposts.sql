@@ -0,0 +1,3 @@
+ALTER TABLE timeline_entries ADD COLUMN repostedBy VARCHAR(36) NULL;
+
+CREATE INDEX idx_timeline_entries_reposted_by_post_id ON timeline_entries (repostedBy, postId);
@@ -0,0 +1,19 @@
+package models
+
+const (
+	AttachmentKindImage    = "image"
+	AttachmentKindVideo    = "video"
+	AttachmentKindDocument = "document"
+
+	MaxAttachmentsPerPost = 10
+)
+
+type PostAttachment struct {
+	Position int64  `json:"position"`
+	Kind     string `json:"kind"`
+	Url      string `json:"url"`
+	MimeType string `json:"mimeType"`
+	Width    int64  `json:"width"`
+	Height   int64  `json:"height"`
+	AltText  string `json:"altText"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type Bookmark struct {
+	UserId    string
+	CreatedAt string
+	Post      *Post
+}
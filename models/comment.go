@@ -0,0 +1,21 @@
+package models
+
+import libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
+
+type PostComments struct {
+	CommentsCount int64
+	Comments      []Comment
+}
+
+type Comment struct {
+	CommentId string
+	UserId    string
+	UserName  string
+	Content   string
+	Type      string
+	Replies   PostComments
+	Likes     libModels.PostLikes
+	Mentions  []MentionedUser
+	CreatedAt string
+	UpdatedAt string
+}
@@ -0,0 +1,22 @@
+package models
+
+const (
+	MentionTargetPost    = "post"
+	MentionTargetComment = "comment"
+	MentionTargetReply   = "reply"
+)
+
+type MentionedUser struct {
+	UserId string `json:"userId"`
+	Name   string `json:"name"`
+}
+
+type Mention struct {
+	TargetType string `json:"targetType"`
+	TargetId   string `json:"targetId"`
+	PostId     string `json:"postId"`
+	AuthorId   string `json:"authorId"`
+	AuthorName string `json:"authorName"`
+	Content    string `json:"content"`
+	CreatedAt  string `json:"createdAt"`
+}
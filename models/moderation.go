@@ -0,0 +1,59 @@
+package models
+
+const (
+	ModerationStatusVisible = "visible"
+	ModerationStatusHeld    = "held"
+	ModerationStatusHidden  = "hidden"
+	ModerationStatusRemoved = "removed"
+)
+
+const (
+	ModerationSourceAutomatic = "automatic"
+	ModerationSourceReports   = "reports"
+)
+
+const (
+	ModerationItemPending   = "pending"
+	ModerationItemApproved  = "approved"
+	ModerationItemRemoved   = "removed"
+	ModerationItemWithdrawn = "withdrawn"
+)
+
+const (
+	ModerationActionApprove = "approve"
+	ModerationActionRemove  = "remove"
+	ModerationActionRestore = "restore"
+	ModerationActionDelete  = "delete"
+)
+
+type ModerationQueueItem struct {
+	TargetType       string `json:"targetType"`
+	TargetId         string `json:"targetId"`
+	PostId           string `json:"postId"`
+	AuthorId         string `json:"authorId"`
+	AuthorName       string `json:"authorName"`
+	Content          string `json:"content"`
+	ModerationStatus string `json:"moderationStatus"`
+	Source           string `json:"source"`
+	Reason           string `json:"reason"`
+	ReportsCount     int64  `json:"reportsCount"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// ModerationAction is an audit entry for something a moderator did.
+type ModerationAction struct {
+	ActionId    string
+	ModeratorId string
+	Action      string
+	TargetType  string
+	TargetId    string
+	PostId      string
+	Reason      string
+}
+
+type ModerationActionParams struct {
+	ModeratorId string
+	TargetType  string
+	TargetId    string
+	Reason      string
+}
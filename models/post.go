@@ -0,0 +1,149 @@
+package models
+
+import (
+	"time"
+
+	libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
+)
+
+const (
+	SearchOrderRelevance = "relevance"
+	SearchOrderRecency   = "recency"
+)
+
+type SearchPostsParams struct {
+	ViewerId string
+	Query    string
+	Types    []string
+	OrderBy  string
+	Cursor   string
+	Limit    int64
+}
+
+const (
+	PostFeedLatest   = "latest"
+	PostFeedTrending = "trending"
+	PostFeedHome     = "home"
+	PostFeedList     = "list"
+	PostFeedDrafts   = "drafts"
+	PostFeedMentions = "mentions"
+)
+
+const (
+	PostActionUpdate       = "update"
+	PostActionUpdateDraft  = "updateDraft"
+	PostActionPublishDraft = "publishDraft"
+	PostActionRestore      = "restore"
+)
+
+const (
+	PostViewRevisions    = "revisions"
+	PostViewRevision     = "revision"
+	PostViewRevisionDiff = "revisionDiff"
+)
+
+const (
+	PostSortNewest        = "newest"
+	PostSortOldest        = "oldest"
+	PostSortMostLiked     = "mostLiked"
+	PostSortMostCommented = "mostCommented"
+)
+
+type PostFilters struct {
+	Types       []string
+	AuthorIds   []string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	HasImage    *bool
+}
+
+type ListPostsParams struct {
+	ViewerId string
+	Filters  PostFilters
+	SortBy   string
+	Cursor   string
+	Limit    int64
+}
+
+const (
+	PostStatusDraft     = "draft"
+	PostStatusScheduled = "scheduled"
+	PostStatusPublished = "published"
+)
+
+const (
+	PostVisibilityPublic    = "public"
+	PostVisibilityUnlisted  = "unlisted"
+	PostVisibilityPrivate   = "private"
+	PostVisibilityFollowers = "followers"
+)
+
+const MaxPinnedPostsPerUser = 3
+
+// Post is a post with the details this service adds to the shared model.
+// QuotedPost is nil when the quoted post was deleted or is hidden from the
+// viewer, while QuotedPostId still names it. BookmarkedByMe is only set when
+// the post is loaded for a viewer.
+type Post struct {
+	libModels.Post
+	Visibility     string
+	Status         string
+	PublishAt      string
+	Tags           []string
+	Mentions       []MentionedUser
+	Attachments    []PostAttachment
+	Pinned         bool
+	QuotedPostId   string
+	QuotedPost     *Post
+	LikesCount     int64
+	CommentsCount  int64
+	RepliesCount   int64
+	RepostsCount   int64
+	QuotesCount    int64
+	BookmarkedByMe bool
+	RepostedBy     string
+	RepostedAt     string
+}
+
+type PostDraftParams struct {
+	PostId         string
+	UserId         string
+	Title          string
+	Content        string
+	Type           string
+	UrlImagePost   string
+	Visibility     string
+	Attachments    []PostAttachment
+	QuotedPostId   string
+	PublishAt      *time.Time
+	ClearPublishAt bool
+}
+
+type PostOptions struct {
+	Visibility   string
+	Attachments  []PostAttachment
+	QuotedPostId string
+}
+
+type PostRevision struct {
+	PostId         string `json:"postId"`
+	RevisionNumber int64  `json:"revisionNumber"`
+	Title          string `json:"title"`
+	Content        string `json:"content"`
+	UrlImagePost   string `json:"urlImagePost"`
+	EditedBy       string `json:"editedBy"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+type PostRevisionFieldDiff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type TagCount struct {
+	Tag        string `json:"tag"`
+	PostsCount int64  `json:"postsCount"`
+}
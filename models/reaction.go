@@ -0,0 +1,23 @@
+package models
+
+const (
+	ReactionLike       = "like"
+	ReactionLove       = "love"
+	ReactionLaugh      = "laugh"
+	ReactionWow        = "wow"
+	ReactionSad        = "sad"
+	ReactionInsightful = "insightful"
+)
+
+const (
+	LikeActionToggle = "toggle"
+	LikeActionLike   = "like"
+	LikeActionUnlike = "unlike"
+)
+
+// ReactionSummary counts the reactions on a post, comment or reply by kind.
+// ViewerReaction is empty when the viewer has not reacted.
+type ReactionSummary struct {
+	Counts         map[string]int64
+	ViewerReaction string
+}
@@ -0,0 +1,21 @@
+package models
+
+const (
+	ReportCategorySpam           = "spam"
+	ReportCategoryHarassment     = "harassment"
+	ReportCategoryHateSpeech     = "hate_speech"
+	ReportCategoryViolence       = "violence"
+	ReportCategoryNudity         = "nudity"
+	ReportCategoryMisinformation = "misinformation"
+	ReportCategoryOther          = "other"
+)
+
+const MaxReportDetailsLength = 1000
+
+type ReportContentParams struct {
+	ReporterId string
+	TargetType string
+	TargetId   string
+	Category   string
+	Details    string
+}
@@ -0,0 +1,7 @@
+package models
+
+type Repost struct {
+	UserId    string
+	CreatedAt string
+	Post      *Post
+}
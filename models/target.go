@@ -0,0 +1,10 @@
+package models
+
+// ContentTarget identifies who owns a post, comment or reply and the post it
+// belongs to.
+type ContentTarget struct {
+	PostId       string
+	OwnerId      string
+	PostAuthorId string
+	PostDeleted  bool
+}
@@ -0,0 +1,97 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func replacePostAttachments(ctx *context.Context, tx *sql.Tx, postId string, attachments []models.PostAttachment) error {
+	_, err := tx.ExecContext(*ctx, `DELETE FROM post_attachments WHERE postId = ?`, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for position, attachment := range attachments {
+		args = append(args, postId, position, attachment.Kind, attachment.Url, attachment.MimeType, attachment.Width, attachment.Height, attachment.AltText)
+	}
+
+	insertQuery := `INSERT INTO post_attachments (postId, position, kind, url, mimeType, width, height, altText) VALUES (?, ?, ?, ?, ?, ?, ?, ?)` +
+		strings.Repeat(", (?, ?, ?, ?, ?, ?, ?, ?)", len(attachments)-1)
+	_, err = tx.ExecContext(*ctx, insertQuery, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func loadPostsAttachments(ctx *context.Context, posts []*models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*models.Post, len(posts))
+	args := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		post.Attachments = make([]models.PostAttachment, 0)
+		postsById[post.PostId] = post
+		args = append(args, post.PostId)
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	pa.postId,
+	pa.position,
+	pa.kind,
+	pa.url,
+	pa.mimeType,
+	pa.width,
+	pa.height,
+	pa.altText
+FROM post_attachments pa
+WHERE pa.postId IN (?%s)
+ORDER BY pa.postId, pa.position ASC`, strings.Repeat(", ?", len(args)-1))
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		var attachment models.PostAttachment
+		err = rows.Scan(
+			&postId,
+			&attachment.Position,
+			&attachment.Kind,
+			&attachment.Url,
+			&attachment.MimeType,
+			&attachment.Width,
+			&attachment.Height,
+			&attachment.AltText,
+		)
+		if err != nil {
+			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		if post, ok := postsById[postId]; ok {
+			post.Attachments = append(post.Attachments, attachment)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *mysqlResource) CreateBookmark(ctx *context.Context, userId, postId string) error {
+	currentTime := time.Now()
+
+	query := `INSERT IGNORE INTO bookmarks (userId, postId, createdAt) VALUES (?, ?, ?)`
+	_, err := mysql.DB.ExecContext(*ctx, query, userId, postId, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) DeleteBookmark(ctx *context.Context, userId, postId string) error {
+	query := `DELETE FROM bookmarks WHERE userId = ? AND postId = ?`
+	_, err := mysql.DB.ExecContext(*ctx, query, userId, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// GetAllBookmarksFromUser lists the posts userId saved, most recently saved
+// first. Bookmarks of posts that were deleted or are no longer visible to the
+// user are left out but kept, so they come back if the post does.
+func (m *mysqlResource) GetAllBookmarksFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Bookmark, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(userId, true)
+	args := append([]interface{}{userId, models.PostStatusPublished}, visibilityArgs...)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (b.createdAt, b.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	b.userId,
+	b.createdAt,
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM bookmarks b
+	JOIN posts p ON b.postId = p.postId
+	JOIN users u ON p.authorId = u.userId
+WHERE b.userId = ? AND p.status = ? AND p.deletedAt IS NULL AND %s %s
+ORDER BY b.createdAt DESC, b.postId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	bookmarks := make([]*models.Bookmark, 0)
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		bookmark := &models.Bookmark{Post: &models.Post{}}
+		err = rows.Scan(
+			&bookmark.UserId,
+			&bookmark.CreatedAt,
+			&bookmark.Post.PostId,
+			&bookmark.Post.AuthorId,
+			&bookmark.Post.AuthorName,
+			&bookmark.Post.Title,
+			&bookmark.Post.Content,
+			&bookmark.Post.Type,
+			&bookmark.Post.UrlImagePost,
+			&bookmark.Post.CreatedAt,
+			&bookmark.Post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		bookmarks = append(bookmarks, bookmark)
+		posts = append(posts, bookmark.Post)
+	}
+
+	err = loadPostsDetails(ctx, posts, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return bookmarks, nil
+}
+
+func loadPostsBookmarkedByViewer(ctx *context.Context, posts []*models.Post, viewerId string) error {
+	if len(posts) == 0 || viewerId == "" {
+		return nil
+	}
+
+	postsById := make(map[string]*models.Post, len(posts))
+	args := []interface{}{viewerId}
+	for _, post := range posts {
+		post.BookmarkedByMe = false
+		postsById[post.PostId] = post
+		args = append(args, post.PostId)
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	b.postId
+FROM bookmarks b
+WHERE b.userId = ? AND b.postId IN (?%s)`, strings.Repeat(", ?", len(posts)-1))
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		err = rows.Scan(&postId)
+		if err != nil {
+			return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		if post, ok := postsById[postId]; ok {
+			post.BookmarkedByMe = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	postLikesCounter    = "likesCount"
+	postCommentsCounter = "commentsCount"
+	postRepliesCounter  = "repliesCount"
+)
+
+// postRepliesQuery lists, per post, the replies counted in repliesCount:
+// visible replies reached from a visible comment through visible replies.
+// Replies left behind by a deleted comment or reply are not counted.
+const postRepliesQuery = `
+WITH RECURSIVE post_replies AS (
+	SELECT c.postId, cr.replyId
+	FROM comment_replies cr
+		JOIN comments c ON cr.commentId = c.commentId
+	WHERE c.moderationStatus = ? AND cr.moderationStatus = ?
+	UNION ALL
+	SELECT r.postId, cr.replyId
+	FROM comment_replies cr
+		JOIN post_replies r ON cr.parentReplyId = r.replyId
+	WHERE cr.moderationStatus = ?
+)`
+
+// RecomputePostCounters recounts likes, visible comments and counted replies
+// for every post and fixes the counters that drifted. It returns how many
+// posts changed.
+func (m *mysqlResource) RecomputePostCounters(ctx *context.Context) (int64, error) {
+	query := postRepliesQuery + `
+UPDATE posts p
+	LEFT JOIN (
+		SELECT l.postId, COUNT(*) AS likesCount
+		FROM likes l
+		GROUP BY l.postId
+	) l ON l.postId = p.postId
+	LEFT JOIN (
+		SELECT c.postId, COUNT(*) AS commentsCount
+		FROM comments c
+		WHERE c.moderationStatus = ?
+		GROUP BY c.postId
+	) c ON c.postId = p.postId
+	LEFT JOIN (
+		SELECT pr.postId, COUNT(*) AS repliesCount
+		FROM post_replies pr
+		GROUP BY pr.postId
+	) r ON r.postId = p.postId
+SET 
+	p.likesCount = IFNULL(l.likesCount, 0),
+	p.commentsCount = IFNULL(c.commentsCount, 0),
+	p.repliesCount = IFNULL(r.repliesCount, 0)
+WHERE p.likesCount <> IFNULL(l.likesCount, 0)
+	OR p.commentsCount <> IFNULL(c.commentsCount, 0)
+	OR p.repliesCount <> IFNULL(r.repliesCount, 0)`
+
+	result, err := mysql.DB.ExecContext(*ctx, query,
+		models.ModerationStatusVisible, models.ModerationStatusVisible, models.ModerationStatusVisible,
+		models.ModerationStatusVisible,
+	)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	reconciled, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return reconciled, nil
+}
+
+func updatePostCounter(ctx *context.Context, tx *sql.Tx, postId, counter string, delta int64) error {
+	query := fmt.Sprintf(`UPDATE posts SET %s = GREATEST(%s + ?, 0) WHERE postId = ?`, counter, counter)
+	_, err := tx.ExecContext(*ctx, query, delta, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// countReplyDescendants counts the visible replies nested under a comment,
+// or under a reply when isReply is set, through visible replies only. These
+// are the replies counted on the post while the root itself is counted.
+func countReplyDescendants(ctx *context.Context, tx *sql.Tx, targetId string, isReply bool) (int64, error) {
+	rootCondition := "cr.commentId = ?"
+	if isReply {
+		rootCondition = "cr.parentReplyId = ?"
+	}
+
+	query := fmt.Sprintf(`
+WITH RECURSIVE descendants AS (
+	SELECT cr.replyId
+	FROM comment_replies cr
+	WHERE %s AND cr.moderationStatus = ?
+	UNION ALL
+	SELECT cr.replyId
+	FROM comment_replies cr
+		JOIN descendants d ON cr.parentReplyId = d.replyId
+	WHERE cr.moderationStatus = ?
+)
+SELECT COUNT(*) FROM descendants`, rootCondition)
+
+	rows, err := tx.QueryContext(*ctx, query, targetId, models.ModerationStatusVisible, models.ModerationStatusVisible)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	var count int64
+	if rows.Next() {
+		err = rows.Scan(&count)
+		if err != nil {
+			return 0, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+	}
+
+	return count, nil
+}
+
+// isCommentCounted reports whether a comment or reply counts on its post: it
+// is visible and, for a reply, so is every comment and reply above it.
+func isCommentCounted(ctx *context.Context, tx *sql.Tx, targetType, targetId string) (bool, error) {
+	var query string
+	switch targetType {
+	case models.MentionTargetComment:
+		query = `SELECT c.moderationStatus = ? FROM comments c WHERE c.commentId = ?`
+	case models.MentionTargetReply:
+		query = `
+WITH RECURSIVE ancestors AS (
+	SELECT cr.replyId, cr.commentId, cr.parentReplyId, cr.moderationStatus
+	FROM comment_replies cr
+	WHERE cr.replyId = ?
+	UNION ALL
+	SELECT cr.replyId, cr.commentId, cr.parentReplyId, cr.moderationStatus
+	FROM comment_replies cr
+		JOIN ancestors a ON cr.replyId = a.parentReplyId
+)
+SELECT IFNULL(SUM(a.moderationStatus <> ?), 0) = 0 AND IFNULL(SUM(c.moderationStatus = ?), 0) = 1
+FROM ancestors a 
+	LEFT JOIN comments c ON a.commentId = c.commentId`
+	default:
+		return false, nil
+	}
+
+	args := []interface{}{models.ModerationStatusVisible, targetId}
+	if targetType == models.MentionTargetReply {
+		args = []interface{}{targetId, models.ModerationStatusVisible, models.ModerationStatusVisible}
+	}
+
+	rows, err := tx.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	var counted bool
+	if rows.Next() {
+		err = rows.Scan(&counted)
+		if err != nil {
+			return false, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+	}
+
+	return counted, nil
+}
+
+// countComment adds (sign 1) or removes (sign -1) a counted comment or reply
+// from its post, along with the replies counted under it.
+func countComment(ctx *context.Context, tx *sql.Tx, postId, targetType, targetId string, sign int64) error {
+	isReply := targetType == models.MentionTargetReply
+	nestedReplies, err := countReplyDescendants(ctx, tx, targetId, isReply)
+	if err != nil {
+		return err
+	}
+
+	if isReply {
+		return updatePostCounter(ctx, tx, postId, postRepliesCounter, sign*(1+nestedReplies))
+	}
+
+	err = updatePostCounter(ctx, tx, postId, postCommentsCounter, sign)
+	if err != nil {
+		return err
+	}
+
+	return updatePostCounter(ctx, tx, postId, postRepliesCounter, sign*nestedReplies)
+}
+
+// setModerationStatus changes the moderation status of a post, comment or
+// reply inside tx, only when it currently is fromStatus if that is set. A
+// comment or reply that starts or stops being visible is counted or uncounted
+// on postId. It reports whether the status changed.
+func setModerationStatus(ctx *context.Context, tx *sql.Tx, targetType, targetId, postId, moderationStatus, fromStatus string) (bool, error) {
+	var query string
+	switch targetType {
+	case models.MentionTargetPost:
+		query = `UPDATE posts SET moderationStatus = ? WHERE postId = ?`
+	case models.MentionTargetComment:
+		query = `UPDATE comments SET moderationStatus = ? WHERE commentId = ?`
+	case models.MentionTargetReply:
+		query = `UPDATE comment_replies SET moderationStatus = ? WHERE replyId = ?`
+	default:
+		return false, status.Error(codes.InvalidArgument, "invalid target type")
+	}
+
+	args := []interface{}{moderationStatus, targetId}
+	if fromStatus != "" {
+		query += " AND moderationStatus = ?"
+		args = append(args, fromStatus)
+	}
+
+	wasCounted, err := isCommentCounted(ctx, tx, targetType, targetId)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(*ctx, query, args...)
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if changed == 0 {
+		return false, nil
+	}
+
+	isCounted, err := isCommentCounted(ctx, tx, targetType, targetId)
+	if err != nil {
+		return false, err
+	}
+
+	if wasCounted != isCounted {
+		sign := int64(1)
+		if wasCounted {
+			sign = -1
+		}
+
+		err = countComment(ctx, tx, postId, targetType, targetId, sign)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func loadPostsEngagementCounts(ctx *context.Context, posts []*models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*models.Post, len(posts))
+	args := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		postsById[post.PostId] = post
+		args = append(args, post.PostId)
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.likesCount,
+	p.commentsCount,
+	p.repliesCount
+FROM posts p 
+WHERE p.postId IN (?%s)`, strings.Repeat(", ?", len(args)-1))
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		var likesCount, commentsCount, repliesCount int64
+		err = rows.Scan(&postId, &likesCount, &commentsCount, &repliesCount)
+		if err != nil {
+			return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		if post, ok := postsById[postId]; ok {
+			post.LikesCount = likesCount
+			post.CommentsCount = commentsCount
+			post.RepliesCount = repliesCount
+		}
+	}
+
+	return nil
+}
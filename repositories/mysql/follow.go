@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *mysqlResource) FollowUser(ctx *context.Context, followerId, followingId string) error {
+	currentTime := time.Now()
+
+	queryValidateUser := `
+SELECT 
+	u.userId
+FROM users u 
+WHERE u.userId = ?`
+	rowUser, err := mysql.DB.QueryContext(*ctx, queryValidateUser, followingId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	userExists := rowUser.Next()
+	rowUser.Close()
+	if !userExists {
+		return status.Error(codes.NotFound, "user not found")
+	}
+
+	query := `INSERT IGNORE INTO follows (followerId, followingId, createdAt) VALUES (?, ?, ?)`
+	_, err = mysql.DB.ExecContext(*ctx, query, followerId, followingId, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// UnfollowUser also drops the posts and reposts of followingId from the
+// timeline of followerId.
+func (m *mysqlResource) UnfollowUser(ctx *context.Context, followerId, followingId string) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(*ctx, `DELETE FROM follows WHERE followerId = ? AND followingId = ?`, followerId, followingId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	query := `DELETE FROM timeline_entries WHERE userId = ? AND ((authorId = ? AND repostedBy IS NULL) OR repostedBy = ?)`
+	_, err = tx.ExecContext(*ctx, query, followerId, followingId, followingId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *mysqlResource) ReplaceMentions(ctx *context.Context, targetType, targetId, postId, authorId string, mentionNames []string) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	err = replaceMentions(ctx, tx, targetType, targetId, postId, authorId, mentionNames)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func replaceMentions(ctx *context.Context, tx *sql.Tx, targetType, targetId, postId, authorId string, mentionNames []string) error {
+	currentTime := time.Now()
+
+	_, err := tx.ExecContext(*ctx, `DELETE FROM mentions WHERE targetType = ? AND targetId = ?`, targetType, targetId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if len(mentionNames) == 0 {
+		return nil
+	}
+
+	args := []interface{}{targetType, targetId, postId, authorId, currentTime.Format("2006-01-02 15:04:05")}
+	for _, mentionName := range mentionNames {
+		args = append(args, mentionName)
+	}
+
+	// Handles are the unique usernames owned by the user service.
+	insertQuery := fmt.Sprintf(`
+INSERT IGNORE INTO mentions (targetType, targetId, postId, mentionedUserId, authorId, createdAt)
+SELECT ?, ?, ?, u.userId, ?, ?
+FROM users u
+WHERE u.username IN (?%s)`, strings.Repeat(", ?", len(mentionNames)-1))
+	_, err = tx.ExecContext(*ctx, insertQuery, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) GetAllMentionsFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Mention, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(userId, true)
+	args := []interface{}{models.MentionTargetPost, models.MentionTargetComment, userId, models.PostStatusPublished}
+	args = append(args, visibilityArgs...)
+	args = append(args, models.MentionTargetPost, models.ModerationStatusVisible, models.ModerationStatusVisible)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (mt.createdAt, mt.targetId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	mt.targetType,
+	mt.targetId,
+	mt.postId,
+	mt.authorId,
+	u.name,
+	CASE mt.targetType WHEN ? THEN p.content WHEN ? THEN c.content ELSE cr.content END AS content,
+	mt.createdAt
+FROM mentions mt
+	JOIN posts p ON mt.postId = p.postId
+	JOIN users u ON mt.authorId = u.userId
+	LEFT JOIN comments c ON mt.targetId = c.commentId
+	LEFT JOIN comment_replies cr ON mt.targetId = cr.replyId
+WHERE mt.mentionedUserId = ? AND p.status = ? AND p.deletedAt IS NULL AND %s
+	AND (mt.targetType = ? OR c.moderationStatus = ? OR cr.moderationStatus = ?) %s
+ORDER BY mt.createdAt DESC, mt.targetId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	mentions := make([]*models.Mention, 0)
+
+	for rows.Next() {
+		mention := &models.Mention{}
+		err = rows.Scan(
+			&mention.TargetType,
+			&mention.TargetId,
+			&mention.PostId,
+			&mention.AuthorId,
+			&mention.AuthorName,
+			&mention.Content,
+			&mention.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return mentions, nil
+}
+
+func getMentionedUsers(ctx *context.Context, targetTypes []string, targetIds []string) (map[string][]models.MentionedUser, error) {
+	mentionedUsers := make(map[string][]models.MentionedUser)
+	if len(targetIds) == 0 {
+		return mentionedUsers, nil
+	}
+
+	var args []interface{}
+	for _, targetType := range targetTypes {
+		args = append(args, targetType)
+	}
+	for _, targetId := range targetIds {
+		args = append(args, targetId)
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	mt.targetId,
+	u.userId,
+	u.name
+FROM mentions mt
+	JOIN users u ON mt.mentionedUserId = u.userId
+WHERE mt.targetType IN (?%s) AND mt.targetId IN (?%s)
+ORDER BY u.name ASC`, strings.Repeat(", ?", len(targetTypes)-1), strings.Repeat(", ?", len(targetIds)-1))
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var targetId string
+		var mentionedUser models.MentionedUser
+		err = rows.Scan(&targetId, &mentionedUser.UserId, &mentionedUser.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		mentionedUsers[targetId] = append(mentionedUsers[targetId], mentionedUser)
+	}
+
+	return mentionedUsers, nil
+}
+
+func loadPostsMentions(ctx *context.Context, posts []*models.Post) error {
+	postIds := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIds = append(postIds, post.PostId)
+	}
+
+	mentionedUsers, err := getMentionedUsers(ctx, []string{models.MentionTargetPost}, postIds)
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		post.Mentions = mentionedUsers[post.PostId]
+		if post.Mentions == nil {
+			post.Mentions = make([]models.MentionedUser, 0)
+		}
+	}
+
+	return nil
+}
+
+func loadCommentsMentions(ctx *context.Context, postComments *models.PostComments) error {
+	commentsById := make(map[string]*models.Comment)
+	collectComments(postComments, commentsById)
+
+	commentIds := make([]string, 0, len(commentsById))
+	for commentId := range commentsById {
+		commentIds = append(commentIds, commentId)
+	}
+
+	mentionedUsers, err := getMentionedUsers(ctx, []string{models.MentionTargetComment, models.MentionTargetReply}, commentIds)
+	if err != nil {
+		return err
+	}
+
+	for commentId, comment := range commentsById {
+		comment.Mentions = mentionedUsers[commentId]
+		if comment.Mentions == nil {
+			comment.Mentions = make([]models.MentionedUser, 0)
+		}
+	}
+
+	return nil
+}
+
+func collectComments(postComments *models.PostComments, commentsById map[string]*models.Comment) {
+	for i := range postComments.Comments {
+		comment := &postComments.Comments[i]
+		commentsById[comment.CommentId] = comment
+		collectComments(&comment.Replies, commentsById)
+	}
+}
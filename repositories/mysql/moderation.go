@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const enqueueModerationItemQuery = `
+INSERT INTO moderation_queue (targetType, targetId, postId, source, reason, status, createdAt)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE source = VALUES(source), reason = VALUES(reason), status = VALUES(status), createdAt = VALUES(createdAt), resolvedBy = NULL, resolvedAt = NULL`
+
+func (m *mysqlResource) EnqueueModerationItem(ctx *context.Context, targetType, targetId, postId, source, reason string) error {
+	currentTime := time.Now()
+
+	_, err := mysql.DB.ExecContext(*ctx, enqueueModerationItemQuery, targetType, targetId, postId, source, reason, models.ModerationItemPending, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+const insertModerationActionQuery = `INSERT INTO moderation_actions (actionId, moderatorId, action, targetType, targetId, postId, reason, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+func (m *mysqlResource) GetModerationQueue(ctx *context.Context, cursor *pagination.Cursor, limit int64) ([]*models.ModerationQueueItem, error) {
+	args := []interface{}{models.MentionTargetPost, models.MentionTargetComment, models.MentionTargetReply, models.ModerationItemPending}
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (mq.createdAt, mq.targetId) > (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	mq.targetType,
+	mq.targetId,
+	mq.postId,
+	COALESCE(p.authorId, c.userId, cr.userId) AS authorId,
+	IFNULL(u.name, "") AS authorName,
+	COALESCE(p.content, c.content, cr.content) AS content,
+	COALESCE(p.moderationStatus, c.moderationStatus, cr.moderationStatus) AS moderationStatus,
+	mq.source,
+	mq.reason,
+	(SELECT COUNT(*) FROM reports r WHERE r.targetType = mq.targetType AND r.targetId = mq.targetId AND %s) AS reportsCount,
+	mq.createdAt
+FROM moderation_queue mq
+	LEFT JOIN posts p ON mq.targetType = ? AND mq.targetId = p.postId AND p.deletedAt IS NULL
+	LEFT JOIN comments c ON mq.targetType = ? AND mq.targetId = c.commentId
+	LEFT JOIN comment_replies cr ON mq.targetType = ? AND mq.targetId = cr.replyId
+	LEFT JOIN users u ON u.userId = COALESCE(p.authorId, c.userId, cr.userId)
+WHERE mq.status = ? AND COALESCE(p.postId, c.commentId, cr.replyId) IS NOT NULL %s
+ORDER BY mq.createdAt ASC, mq.targetId ASC
+LIMIT ?`, openReportCondition, cursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	items := make([]*models.ModerationQueueItem, 0)
+
+	for rows.Next() {
+		item := &models.ModerationQueueItem{}
+		err = rows.Scan(
+			&item.TargetType,
+			&item.TargetId,
+			&item.PostId,
+			&item.AuthorId,
+			&item.AuthorName,
+			&item.Content,
+			&item.ModerationStatus,
+			&item.Source,
+			&item.Reason,
+			&item.ReportsCount,
+			&item.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// ApplyModerationAction sets the moderation status of a post, comment or
+// reply, updates the post counters, resolves its queue item and records the
+// action in one transaction.
+func (m *mysqlResource) ApplyModerationAction(ctx *context.Context, actionId, moderatorId, action, targetType, targetId, postId, moderationStatus, queueStatus, reason string) error {
+	currentTime := time.Now()
+
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	_, err = setModerationStatus(ctx, tx, targetType, targetId, postId, moderationStatus, "")
+	if err != nil {
+		return err
+	}
+
+	queueQuery := `UPDATE moderation_queue SET status = ?, resolvedBy = ?, resolvedAt = ? WHERE targetType = ? AND targetId = ?`
+	_, err = tx.ExecContext(*ctx, queueQuery, queueStatus, moderatorId, currentTime.Format("2006-01-02 15:04:05"), targetType, targetId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	_, err = tx.ExecContext(*ctx, insertModerationActionQuery, actionId, moderatorId, action, targetType, targetId, postId, reason, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// recordModerationAction writes action inside tx, so the audit entry commits
+// or rolls back together with the change it describes. A nil action is a
+// no-op.
+func recordModerationAction(ctx *context.Context, tx *sql.Tx, action *models.ModerationAction) error {
+	if action == nil {
+		return nil
+	}
+
+	currentTime := time.Now()
+
+	_, err := tx.ExecContext(*ctx, insertModerationActionQuery, action.ActionId, action.ModeratorId, action.Action, action.TargetType, action.TargetId, action.PostId, action.Reason, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
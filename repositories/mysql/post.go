@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
 	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,64 +20,1007 @@ type mysqlResource struct {
 }
 
 type IMySqlPost interface {
-	CreatePost(ctx *context.Context, userId, postId, title, content, postType, urlImagePost string) error
-	GetPost(ctx *context.Context, postId string) (*libModels.Post, error)
-	GetAllPosts(ctx *context.Context) ([]*libModels.Post, error)
-	GetAllPostsFromUser(ctx *context.Context, userId string) ([]*libModels.Post, error)
-	UpdatePost(ctx *context.Context, postId, userId, title, content, urlImagePost string) error
-	DeletePost(ctx *context.Context, postId, userId string) error
+	CreatePost(ctx *context.Context, userId, postId, title, content, postType, urlImagePost, visibility, postStatus, moderationStatus, quotedPostId, holdReason string, publishAt *time.Time, attachments []models.PostAttachment, tags, mentionNames []string) error
+	GetPostsQuotaRetryAfter(ctx *context.Context, userId string, since time.Time, quota int64) (time.Duration, error)
+	GetPost(ctx *context.Context, postId, viewerId string) (*models.Post, error)
+	GetAuthorizedPost(ctx *context.Context, postId, viewerId string) (*models.Post, error)
+	GetAllPosts(ctx *context.Context, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error)
+	GetAllPostsFromUser(ctx *context.Context, userId, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error)
+	GetPinnedPostsFromUser(ctx *context.Context, userId, viewerId string) ([]*models.Post, error)
+	PinPost(ctx *context.Context, postId, userId string, maxPinned int64) error
+	UnpinPost(ctx *context.Context, postId string) error
+	CreateRepost(ctx *context.Context, userId, postId string) error
+	DeleteRepost(ctx *context.Context, userId, postId string) error
+	GetAllRepostsFromUser(ctx *context.Context, userId, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Repost, error)
+	CreateBookmark(ctx *context.Context, userId, postId string) error
+	DeleteBookmark(ctx *context.Context, userId, postId string) error
+	GetAllBookmarksFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Bookmark, error)
+	FollowUser(ctx *context.Context, followerId, followingId string) error
+	UnfollowUser(ctx *context.Context, followerId, followingId string) error
+	FanOutPost(ctx *context.Context, postId string, maxFollowers int64) (int64, error)
+	FanOutRepost(ctx *context.Context, userId, postId string, maxFollowers int64) (int64, error)
+	BackfillTimeline(ctx *context.Context, userId, authorId string, limit int64) (int64, error)
+	DeleteTimelineEntries(ctx *context.Context, postId string) error
+	AddTimelinePullPost(ctx *context.Context, postId string) error
+	GetHomeTimeline(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error)
+	ListPosts(ctx *context.Context, viewerId string, filters *models.PostFilters, sortBy string, cursor *pagination.Cursor, offset, limit int64) ([]*models.Post, error)
+	SearchPosts(ctx *context.Context, viewerId, searchQuery string, postTypes []string, orderBy string, offset, limit int64) ([]*models.Post, error)
+	UpdatePost(ctx *context.Context, postId, userId, title, content, urlImagePost, visibility, moderationStatus string, attachments []models.PostAttachment) error
+	GetAllRevisionsFromPost(ctx *context.Context, postId string) ([]*models.PostRevision, error)
+	GetRevision(ctx *context.Context, postId string, revisionNumber int64) (*models.PostRevision, error)
+	DeletePost(ctx *context.Context, postId string, moderationAction *models.ModerationAction) error
+	RestorePost(ctx *context.Context, postId string, deletedAfter time.Time) error
+	PurgeDeletedPosts(ctx *context.Context, deletedBefore time.Time, batchSize int64) (int64, error)
+	GetDraft(ctx *context.Context, postId, userId string) (*models.Post, error)
+	GetAllDraftsFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error)
+	UpdateDraft(ctx *context.Context, postId, title, content, urlImagePost, visibility, quotedPostId, postStatus, moderationStatus string, publishAt *time.Time, attachments []models.PostAttachment) error
+	PublishDraft(ctx *context.Context, postId string) error
+	PublishScheduledPosts(ctx *context.Context, now time.Time) ([]string, error)
+	EnqueueModerationItem(ctx *context.Context, targetType, targetId, postId, source, reason string) error
+	GetModerationQueue(ctx *context.Context, cursor *pagination.Cursor, limit int64) ([]*models.ModerationQueueItem, error)
+	ApplyModerationAction(ctx *context.Context, actionId, moderatorId, action, targetType, targetId, postId, moderationStatus, queueStatus, reason string) error
+	GetTarget(ctx *context.Context, targetType, targetId string) (*models.ContentTarget, error)
+	GetUserRoles(ctx *context.Context, userId string) ([]string, error)
+	CreateReport(ctx *context.Context, reportId, targetType, targetId, postId, reporterId, category, details string) (bool, error)
+	CountReports(ctx *context.Context, targetType, targetId string) (int64, error)
+	HideContent(ctx *context.Context, targetType, targetId, postId string) (bool, error)
+	ReplacePostTags(ctx *context.Context, postId string, tags []string) error
+	GetAllPostsFromTag(ctx *context.Context, tag, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error)
+	GetTrendingTags(ctx *context.Context, since time.Time, limit int64) ([]*models.TagCount, error)
+	RecomputePostScores(ctx *context.Context, now, since time.Time, commentWeight, gravity float64) (int64, error)
+	GetTrendingPosts(ctx *context.Context, viewerId string, offset, limit int64) ([]*models.Post, error)
+	ReplaceMentions(ctx *context.Context, targetType, targetId, postId, authorId string, mentionNames []string) error
+	GetAllMentionsFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Mention, error)
 	GetAllLikesFromPost(ctx *context.Context, postId, userId string) (*libModels.PostLikes, error)
 	GetAllLikesFromComment(ctx *context.Context, commentId, userId string) (*libModels.PostLikes, error)
-	UpdateLikesFromPostOrComments(ctx *context.Context, postId, commentId, userId, likeType string) error
-	GetAllCommentsFromPost(ctx *context.Context, postId, userId string) (*libModels.PostComments, error)
-	CreateComment(ctx *context.Context, postId, commentId, userId, content string) error
-	CreateReply(ctx *context.Context, commentId, replyId, userId, content string) error
-	DeleteComment(ctx *context.Context, commentId, userId string) error
-	DeleteReply(ctx *context.Context, replyId, userId string) error
+	UpdateLikesFromPostOrComments(ctx *context.Context, postId, commentId, userId, likeType, reaction string) error
+	AddLike(ctx *context.Context, postId, commentId, userId, likeType, reaction string) error
+	RemoveLike(ctx *context.Context, postId, commentId, userId, likeType string) error
+	GetAllCommentsFromPost(ctx *context.Context, postId, userId string) (*models.PostComments, error)
+	CreateComment(ctx *context.Context, postId, commentId, userId, content, moderationStatus string) error
+	CreateReply(ctx *context.Context, commentId, replyId, userId, content, moderationStatus string) error
+	DeleteComment(ctx *context.Context, commentId string, moderationAction *models.ModerationAction) error
+	DeleteReply(ctx *context.Context, replyId string, moderationAction *models.ModerationAction) error
+	RecomputePostCounters(ctx *context.Context) (int64, error)
 }
 
-func (m *mysqlResource) CreatePost(ctx *context.Context, userId, postId, title, content, postType, urlImagePost string) error {
+// CreatePost inserts a post with its attachments, tags and mentions in one
+// transaction, queueing it for review when holdReason is set.
+func (m *mysqlResource) CreatePost(ctx *context.Context, userId, postId, title, content, postType, urlImagePost, visibility, postStatus, moderationStatus, quotedPostId, holdReason string, publishAt *time.Time, attachments []models.PostAttachment, tags, mentionNames []string) error {
 	currentTime := time.Now()
 
-	if urlImagePost == "" {
-		urlImagePost = "NULL"
-	} else {
-		urlImagePost = fmt.Sprintf("'%s'", urlImagePost)
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	baseQuery := `INSERT INTO posts (authorId, postId, title, content, type, urlImagePost, visibility, status, moderationStatus, quotedPostId, publishAt, createdAt) VALUES (?, ?, ?, ?, ?, NULLIF(?, ''), ?, ?, ?, NULLIF(?, ''), ?, ?)`
+	_, err = tx.ExecContext(*ctx, baseQuery, userId, postId, title, content, postType, urlImagePost, visibility, postStatus, moderationStatus, quotedPostId, formatNullableTime(publishAt), currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = replacePostAttachments(ctx, tx, postId, attachments)
+	if err != nil {
+		return err
+	}
+
+	if holdReason != "" {
+		_, err = tx.ExecContext(*ctx, enqueueModerationItemQuery, models.MentionTargetPost, postId, postId, models.ModerationSourceAutomatic, holdReason, models.ModerationItemPending, currentTime.Format("2006-01-02 15:04:05"))
+		if err != nil {
+			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+	}
+
+	err = replacePostTags(ctx, tx, postId, tags)
+	if err != nil {
+		return err
+	}
+
+	err = replaceMentions(ctx, tx, models.MentionTargetPost, postId, postId, userId, mentionNames)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// GetPostsQuotaRetryAfter returns how long userId must wait before creating
+// another post when quota posts were already created since the given time,
+// or 0 when the user is under quota. Deleted posts and drafts count too.
+func (m *mysqlResource) GetPostsQuotaRetryAfter(ctx *context.Context, userId string, since time.Time, quota int64) (time.Duration, error) {
+	query := `
+SELECT 
+	TIMESTAMPDIFF(SECOND, ?, p.createdAt)
+FROM posts p 
+WHERE p.authorId = ? AND p.createdAt >= ?
+ORDER BY p.createdAt DESC
+LIMIT 1 OFFSET ?`
+
+	sinceFormatted := since.Format("2006-01-02 15:04:05")
+	rows, err := mysql.DB.QueryContext(*ctx, query, sinceFormatted, userId, sinceFormatted, quota-1)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, nil
+	}
+
+	var retryAfterSeconds int64
+	err = rows.Scan(&retryAfterSeconds)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+	}
+
+	return time.Duration(retryAfterSeconds+1) * time.Second, nil
+}
+
+func (m *mysqlResource) GetPost(ctx *context.Context, postId, viewerId string) (*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, true)
+	return getPost(ctx, postId, viewerId, visibilityCondition, visibilityArgs)
+}
+
+// GetAuthorizedPost reads a published post without the visibility filter,
+// for callers that already authorized viewerId on it, such as a moderator
+// editing a private post.
+func (m *mysqlResource) GetAuthorizedPost(ctx *context.Context, postId, viewerId string) (*models.Post, error) {
+	return getPost(ctx, postId, viewerId, "TRUE", nil)
+}
+
+func getPost(ctx *context.Context, postId, viewerId, visibilityCondition string, visibilityArgs []interface{}) (*models.Post, error) {
+	var post models.Post
+
+	args := append([]interface{}{postId, models.PostStatusPublished}, visibilityArgs...)
+
+	query := fmt.Sprintf(`
+SELECT 
+    p.postId,
+    p.authorId,
+    u.name,
+    p.title,
+    p.content,
+    p.type,
+   	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE p.postId = ? AND p.status = ? AND p.deletedAt IS NULL AND %s`, visibilityCondition)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+
+	err = rows.Scan(
+		&post.PostId,
+		&post.AuthorId,
+		&post.AuthorName,
+		&post.Title,
+		&post.Content,
+		&post.Type,
+		&post.UrlImagePost,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = loadPostsDetails(ctx, []*models.Post{&post}, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &post, nil
+}
+
+func (m *mysqlResource) GetAllPosts(ctx *context.Context, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{models.PostStatusPublished}, visibilityArgs...)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (p.createdAt, p.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE p.status = ? AND p.deletedAt IS NULL AND %s %s
+ORDER BY p.createdAt DESC, p.postId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (m *mysqlResource) GetAllPostsFromUser(ctx *context.Context, userId, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{userId, models.PostStatusPublished}, visibilityArgs...)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (p.createdAt, p.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE p.authorId = ? AND p.status = ? AND p.deletedAt IS NULL AND p.pinnedAt IS NULL AND %s %s
+ORDER BY p.createdAt DESC, p.postId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (m *mysqlResource) GetPinnedPostsFromUser(ctx *context.Context, userId, viewerId string) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{userId, models.PostStatusPublished}, visibilityArgs...)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE p.authorId = ? AND p.status = ? AND p.deletedAt IS NULL AND p.pinnedAt IS NOT NULL AND %s
+ORDER BY p.pinnedAt DESC, p.postId DESC`, visibilityCondition)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{Pinned: true}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// PinPost pins a published post of userId, failing once the user already has
+// maxPinned pinned posts. Pinning an already pinned post is a no-op.
+func (m *mysqlResource) PinPost(ctx *context.Context, postId, userId string, maxPinned int64) error {
+	currentTime := time.Now()
+
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	queryPinned := `
+SELECT 
+	p.postId
+FROM posts p 
+WHERE p.authorId = ? AND p.pinnedAt IS NOT NULL AND p.deletedAt IS NULL
+FOR UPDATE`
+
+	rows, err := tx.QueryContext(*ctx, queryPinned, userId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	var pinnedCount int64
+	for rows.Next() {
+		var pinnedPostId string
+		err = rows.Scan(&pinnedPostId)
+		if err != nil {
+			rows.Close()
+			return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		if pinnedPostId == postId {
+			rows.Close()
+			return nil
+		}
+		pinnedCount++
+	}
+	rows.Close()
+
+	if pinnedCount >= maxPinned {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("a user can pin at most %d posts", maxPinned))
+	}
+
+	pinQuery := `UPDATE posts SET pinnedAt = ? WHERE postId = ? AND status = ? AND deletedAt IS NULL`
+	result, err := tx.ExecContext(*ctx, pinQuery, currentTime.Format("2006-01-02 15:04:05"), postId, models.PostStatusPublished)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	pinned, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if pinned == 0 {
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) UnpinPost(ctx *context.Context, postId string) error {
+	unpinQuery := `UPDATE posts SET pinnedAt = NULL WHERE postId = ?`
+	_, err := mysql.DB.ExecContext(*ctx, unpinQuery, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) ListPosts(ctx *context.Context, viewerId string, filters *models.PostFilters, sortBy string, cursor *pagination.Cursor, offset, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	conditions, args := buildPostFiltersConditions(filters)
+	conditions = append([]string{"p.status = ?", "p.deletedAt IS NULL", visibilityCondition}, conditions...)
+	args = append(append([]interface{}{models.PostStatusPublished}, visibilityArgs...), args...)
+
+	var orderClause string
+	switch sortBy {
+	case models.PostSortOldest:
+		if cursor != nil {
+			conditions = append(conditions, "(p.createdAt, p.postId) > (?, ?)")
+			args = append(args, cursor.CreatedAt, cursor.Id)
+		}
+		orderClause = "p.createdAt ASC, p.postId ASC"
+	case models.PostSortMostLiked:
+		orderClause = "p.likesCount DESC, p.createdAt DESC, p.postId DESC"
+	case models.PostSortMostCommented:
+		orderClause = "p.commentsCount DESC, p.createdAt DESC, p.postId DESC"
+	default:
+		if cursor != nil {
+			conditions = append(conditions, "(p.createdAt, p.postId) < (?, ?)")
+			args = append(args, cursor.CreatedAt, cursor.Id)
+		}
+		orderClause = "p.createdAt DESC, p.postId DESC"
+	}
+
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+%s
+ORDER BY %s
+LIMIT ? OFFSET ?`, whereClause, orderClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (m *mysqlResource) SearchPosts(ctx *context.Context, viewerId, searchQuery string, postTypes []string, orderBy string, offset, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{searchQuery, searchQuery, models.PostStatusPublished}, visibilityArgs...)
+
+	typeClause := ""
+	if len(postTypes) > 0 {
+		typeClause = "AND p.type IN (?" + strings.Repeat(", ?", len(postTypes)-1) + ")"
+		for _, postType := range postTypes {
+			args = append(args, postType)
+		}
+	}
+
+	orderClause := "score DESC, p.createdAt DESC, p.postId DESC"
+	if orderBy == models.SearchOrderRecency {
+		orderClause = "p.createdAt DESC, p.postId DESC"
+	}
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt,
+	MATCH(p.title, p.content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE MATCH(p.title, p.content) AGAINST (? IN NATURAL LANGUAGE MODE) AND p.status = ? AND p.deletedAt IS NULL AND %s %s
+ORDER BY %s
+LIMIT ? OFFSET ?`, visibilityCondition, typeClause, orderClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		var score float64
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&score,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (m *mysqlResource) UpdatePost(ctx *context.Context, postId, userId, title, content, urlImagePost, visibility, moderationStatus string, attachments []models.PostAttachment) error {
+	currentTime := time.Now()
+
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	queryValidate := `
+SELECT 
+    p.title,
+	p.content,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.visibility
+FROM posts p 
+WHERE p.postId = ? AND p.status = ? AND p.deletedAt IS NULL
+FOR UPDATE`
+
+	var p libModels.Post
+	var currentVisibility string
+	rows, err := tx.QueryContext(*ctx, queryValidate, postId, models.PostStatusPublished)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if !rows.Next() {
+		rows.Close()
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	err = rows.Scan(&p.Title, &p.Content, &p.UrlImagePost, &currentVisibility)
+	rows.Close()
+	if err != nil {
+		return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+	}
+
+	var setParts []string
+	var args []interface{}
+	if content != "" && p.Content != content {
+		setParts = append(setParts, "content = ?")
+		args = append(args, content)
+	}
+	if urlImagePost != "" && p.UrlImagePost != urlImagePost {
+		setParts = append(setParts, "urlImagePost = ?")
+		args = append(args, urlImagePost)
+	}
+	if title != "" && p.Title != title {
+		setParts = append(setParts, "title = ?")
+		args = append(args, title)
+	}
+	if visibility != "" && currentVisibility != visibility {
+		setParts = append(setParts, "visibility = ?")
+		args = append(args, visibility)
+	}
+	if moderationStatus != "" && len(setParts) > 0 {
+		setParts = append(setParts, "moderationStatus = ?")
+		args = append(args, moderationStatus)
+	}
+
+	if len(setParts) == 0 && attachments == nil {
+		return status.Error(codes.NotFound, "no fields to update")
+	}
+
+	if attachments != nil {
+		err = replacePostAttachments(ctx, tx, postId, attachments)
+		if err != nil {
+			return err
+		}
+	}
+
+	revisionQuery := `
+INSERT INTO post_revisions (postId, revisionNumber, title, content, urlImagePost, editedBy, createdAt)
+SELECT ?, IFNULL(MAX(pr.revisionNumber), 0) + 1, ?, ?, NULLIF(?, ''), ?, ?
+FROM post_revisions pr
+WHERE pr.postId = ?`
+	_, err = tx.ExecContext(*ctx, revisionQuery, postId, p.Title, p.Content, p.UrlImagePost, userId, currentTime.Format("2006-01-02 15:04:05"), postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	setParts = append([]string{"updatedAt = ?"}, setParts...)
+	updateQuery := fmt.Sprintf(`UPDATE posts SET %s WHERE postId = ?`, strings.Join(setParts, ", "))
+	args = append([]interface{}{currentTime.Format("2006-01-02 15:04:05")}, args...)
+	args = append(args, postId)
+
+	_, err = tx.ExecContext(*ctx, updateQuery, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) GetAllRevisionsFromPost(ctx *context.Context, postId string) ([]*models.PostRevision, error) {
+	query := `
+SELECT 
+	pr.postId,
+	pr.revisionNumber,
+	pr.title,
+	pr.content,
+	IFNULL(pr.urlImagePost, "") AS urlImagePost,
+	pr.editedBy,
+	pr.createdAt
+FROM post_revisions pr
+WHERE pr.postId = ?
+ORDER BY pr.revisionNumber DESC`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, postId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	revisions := make([]*models.PostRevision, 0)
+
+	for rows.Next() {
+		revision := &models.PostRevision{}
+		err = rows.Scan(
+			&revision.PostId,
+			&revision.RevisionNumber,
+			&revision.Title,
+			&revision.Content,
+			&revision.UrlImagePost,
+			&revision.EditedBy,
+			&revision.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (m *mysqlResource) GetRevision(ctx *context.Context, postId string, revisionNumber int64) (*models.PostRevision, error) {
+	var revision models.PostRevision
+
+	query := `
+SELECT 
+	pr.postId,
+	pr.revisionNumber,
+	pr.title,
+	pr.content,
+	IFNULL(pr.urlImagePost, "") AS urlImagePost,
+	pr.editedBy,
+	pr.createdAt
+FROM post_revisions pr
+WHERE pr.postId = ? AND pr.revisionNumber = ?`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, postId, revisionNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+
+	err = rows.Scan(
+		&revision.PostId,
+		&revision.RevisionNumber,
+		&revision.Title,
+		&revision.Content,
+		&revision.UrlImagePost,
+		&revision.EditedBy,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return &revision, nil
+}
+
+// DeletePost soft deletes a post. moderationAction, when set, is recorded in
+// the same transaction.
+func (m *mysqlResource) DeletePost(ctx *context.Context, postId string, moderationAction *models.ModerationAction) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	deleteQuery := `UPDATE posts SET deletedAt = ?, pinnedAt = NULL WHERE postId = ? AND deletedAt IS NULL`
+	result, err := tx.ExecContext(*ctx, deleteQuery, time.Now().Format("2006-01-02 15:04:05"), postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if deleted == 0 {
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	err = recordModerationAction(ctx, tx, moderationAction)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) RestorePost(ctx *context.Context, postId string, deletedAfter time.Time) error {
+	queryValidate := `
+SELECT 
+	p.postId
+FROM posts p 
+WHERE p.postId = ? AND p.deletedAt IS NOT NULL`
+
+	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return status.Error(codes.NotFound, "deleted post not found")
+	}
+
+	restoreQuery := `UPDATE posts SET deletedAt = NULL WHERE postId = ? AND deletedAt >= ?`
+	result, err := mysql.DB.ExecContext(*ctx, restoreQuery, postId, deletedAfter.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	restored, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if restored == 0 {
+		return status.Error(codes.FailedPrecondition, "restore window for this post has expired")
+	}
+
+	return nil
+}
+
+func (m *mysqlResource) PurgeDeletedPosts(ctx *context.Context, deletedBefore time.Time, batchSize int64) (int64, error) {
+	query := `
+SELECT 
+	p.postId
+FROM posts p 
+WHERE p.deletedAt IS NOT NULL AND p.deletedAt < ?
+LIMIT ?`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, deletedBefore.Format("2006-01-02 15:04:05"), batchSize)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	var postIds []interface{}
+	for rows.Next() {
+		var postId string
+		err = rows.Scan(&postId)
+		if err != nil {
+			rows.Close()
+			return 0, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		postIds = append(postIds, postId)
+	}
+	rows.Close()
+
+	if len(postIds) == 0 {
+		return 0, nil
 	}
-	baseQuery := fmt.Sprintf(`INSERT INTO posts (authorId, postId, title, content, type, urlImagePost, createdAt) VALUES (?, ?, ?, ?, ?, %s, ?)`, urlImagePost)
-	_, err := mysql.DB.ExecContext(*ctx, baseQuery, userId, postId, title, content, postType, currentTime.Format("2006-01-02 15:04:05"))
+
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
 	if err != nil {
-		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	postIdsPlaceholders := "?" + strings.Repeat(", ?", len(postIds)-1)
+	postRepliesQuery := fmt.Sprintf(`
+WITH RECURSIVE post_replies AS (
+	SELECT cr.replyId
+	FROM comment_replies cr
+		JOIN comments c ON cr.commentId = c.commentId
+	WHERE c.postId IN (%s)
+	UNION ALL
+	SELECT cr.replyId
+	FROM comment_replies cr
+		JOIN post_replies r ON cr.parentReplyId = r.replyId
+)`, postIdsPlaceholders)
+
+	purgeQueries := []string{
+		postRepliesQuery + `
+DELETE FROM comment_likes WHERE replyId IN (SELECT replyId FROM post_replies)`,
+		postRepliesQuery + `
+DELETE FROM comment_replies WHERE replyId IN (SELECT replyId FROM post_replies)`,
+		fmt.Sprintf(`DELETE FROM comment_likes WHERE commentId IN (SELECT c.commentId FROM comments c WHERE c.postId IN (%s))`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM comments WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM likes WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM post_revisions WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM post_tags WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM post_attachments WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM mentions WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM reports WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM reposts WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM bookmarks WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM post_scores WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM timeline_entries WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM timeline_pull_posts WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM moderation_queue WHERE postId IN (%s)`, postIdsPlaceholders),
+		fmt.Sprintf(`DELETE FROM posts WHERE postId IN (%s)`, postIdsPlaceholders),
+	}
+
+	for _, purgeQuery := range purgeQueries {
+		_, err = tx.ExecContext(*ctx, purgeQuery, postIds...)
+		if err != nil {
+			return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
 	}
 
-	return nil
+	err = tx.Commit()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return int64(len(postIds)), nil
 }
 
-func (m *mysqlResource) GetPost(ctx *context.Context, postId string) (*libModels.Post, error) {
-	var post libModels.Post
+func (m *mysqlResource) GetDraft(ctx *context.Context, postId, userId string) (*models.Post, error) {
+	var post models.Post
 
 	query := `
 SELECT 
-    p.postId,
-    p.authorId,
-    u.name,
-    p.title,
-    p.content,
-    p.type,
-   	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.visibility,
+	p.status,
+	IFNULL(p.publishAt, "") AS publishAt,
 	p.createdAt, 
 	IFNULL(p.updatedAt, "") AS updatedAt
 FROM posts p 
 	JOIN users u ON p.authorId = u.userId
-WHERE p.postId = ?`
-	rows, err := mysql.DB.QueryContext(*ctx, query, postId)
+WHERE p.postId = ? AND p.authorId = ? AND p.status <> ? AND p.deletedAt IS NULL`
+	rows, err := mysql.DB.QueryContext(*ctx, query, postId, userId, models.PostStatusPublished)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
 	defer rows.Close()
 	if !rows.Next() {
-		return nil, status.Error(codes.NotFound, "post not found")
+		return nil, status.Error(codes.NotFound, "draft not found")
 	}
 
 	err = rows.Scan(
@@ -85,6 +1031,9 @@ WHERE p.postId = ?`
 		&post.Content,
 		&post.Type,
 		&post.UrlImagePost,
+		&post.Visibility,
+		&post.Status,
+		&post.PublishAt,
 		&post.CreatedAt,
 		&post.UpdatedAt,
 	)
@@ -92,11 +1041,24 @@ WHERE p.postId = ?`
 		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
+	err = loadPostsDetails(ctx, []*models.Post{&post}, userId)
+	if err != nil {
+		return nil, err
+	}
+
 	return &post, nil
 }
 
-func (m *mysqlResource) GetAllPosts(ctx *context.Context) ([]*libModels.Post, error) {
-	query := `
+func (m *mysqlResource) GetAllDraftsFromUser(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error) {
+	args := []interface{}{userId, models.PostStatusPublished}
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (p.createdAt, p.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
 SELECT 
 	p.postId,
 	p.authorId,
@@ -105,23 +1067,27 @@ SELECT
 	p.content,
 	p.type,
 	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.visibility,
+	p.status,
+	IFNULL(p.publishAt, "") AS publishAt,
 	p.createdAt, 
 	IFNULL(p.updatedAt, "") AS updatedAt
 FROM posts p 
 	JOIN users u ON p.authorId = u.userId
-ORDER BY p.createdAt DESC`
-
-	rows, err := mysql.DB.QueryContext(*ctx, query)
+WHERE p.authorId = ? AND p.status <> ? AND p.deletedAt IS NULL %s
+ORDER BY p.createdAt DESC, p.postId DESC
+LIMIT ?`, cursorClause)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
 	defer rows.Close()
 
-	posts := make([]*libModels.Post, 0)
+	posts := make([]*models.Post, 0)
 
 	for rows.Next() {
-		p := &libModels.Post{}
+		p := &models.Post{}
 		err = rows.Scan(
 			&p.PostId,
 			&p.AuthorId,
@@ -130,6 +1096,9 @@ ORDER BY p.createdAt DESC`
 			&p.Content,
 			&p.Type,
 			&p.UrlImagePost,
+			&p.Visibility,
+			&p.Status,
+			&p.PublishAt,
 			&p.CreatedAt,
 			&p.UpdatedAt,
 		)
@@ -141,114 +1110,226 @@ ORDER BY p.createdAt DESC`
 		posts = append(posts, p)
 	}
 
+	err = loadPostsDetails(ctx, posts, userId)
+	if err != nil {
+		return nil, err
+	}
+
 	return posts, nil
 }
 
-func (m *mysqlResource) GetAllPostsFromUser(ctx *context.Context, userId string) ([]*libModels.Post, error) {
+// UpdateDraft edits a draft or scheduled post. Empty strings and nil
+// attachments keep the current values. postStatus is empty to keep the
+// current status and publishAt, and otherwise replaces both. A
+// moderationStatus of visible only releases drafts held by the automatic
+// filters and withdraws their pending queue entry.
+func (m *mysqlResource) UpdateDraft(ctx *context.Context, postId, title, content, urlImagePost, visibility, quotedPostId, postStatus, moderationStatus string, publishAt *time.Time, attachments []models.PostAttachment) error {
+	currentTime := time.Now()
 
-	query := `
-SELECT 
-	p.postId,
-	p.authorId,
-	u.name,
-	p.title,
-	p.content,
-	p.type,
-	IFNULL(p.urlImagePost, "") AS urlImagePost,
-	p.createdAt, 
-	IFNULL(p.updatedAt, "") AS updatedAt
-FROM posts p 
-	JOIN users u ON p.authorId = u.userId
-WHERE p.authorId = ?
-ORDER BY p.createdAt DESC`
-	rows, err := mysql.DB.QueryContext(*ctx, query, userId)
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
+	defer tx.Rollback()
 
-	defer rows.Close()
+	currentStatus, currentModerationStatus, err := lockDraft(ctx, tx, postId)
+	if err != nil {
+		return err
+	}
 
-	posts := make([]*libModels.Post, 0)
+	moderationStatus, release := draftModerationTransition(currentModerationStatus, moderationStatus)
 
-	for rows.Next() {
-		p := &libModels.Post{}
-		err = rows.Scan(
-			&p.PostId,
-			&p.AuthorId,
-			&p.AuthorName,
-			&p.Title,
-			&p.Content,
-			&p.Type,
-			&p.UrlImagePost,
-			&p.CreatedAt,
-			&p.UpdatedAt,
-		)
+	setClause := `
+	title = COALESCE(NULLIF(?, ''), title),
+	content = COALESCE(NULLIF(?, ''), content),
+	urlImagePost = COALESCE(NULLIF(?, ''), urlImagePost),
+	visibility = COALESCE(NULLIF(?, ''), visibility),
+	quotedPostId = COALESCE(NULLIF(?, ''), quotedPostId),
+	moderationStatus = COALESCE(NULLIF(?, ''), moderationStatus),
+	updatedAt = ?`
+	args := []interface{}{title, content, urlImagePost, visibility, quotedPostId, moderationStatus, currentTime.Format("2006-01-02 15:04:05")}
+	if postStatus != "" {
+		setClause += ", status = ?, publishAt = ?"
+		args = append(args, postStatus, formatNullableTime(publishAt))
+	}
+	args = append(args, postId, currentStatus)
+
+	updateQuery := fmt.Sprintf(`UPDATE posts SET %s WHERE postId = ? AND status = ?`, setClause)
+	_, err = tx.ExecContext(*ctx, updateQuery, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
 
+	if attachments != nil {
+		err = replacePostAttachments(ctx, tx, postId, attachments)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+			return err
 		}
+	}
 
-		posts = append(posts, p)
+	if release {
+		query := `UPDATE moderation_queue SET status = ?, resolvedAt = ? WHERE targetType = ? AND targetId = ? AND source = ? AND status = ?`
+		_, err = tx.ExecContext(*ctx, query, models.ModerationItemWithdrawn, currentTime.Format("2006-01-02 15:04:05"), models.MentionTargetPost, postId, models.ModerationSourceAutomatic, models.ModerationItemPending)
+		if err != nil {
+			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
 	}
 
-	return posts, nil
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
 }
 
-func (m *mysqlResource) UpdatePost(ctx *context.Context, postId, userId, title, content, urlImagePost string) error {
+// PublishDraft publishes a draft or scheduled post now.
+func (m *mysqlResource) PublishDraft(ctx *context.Context, postId string) error {
 	currentTime := time.Now()
 
-	queryValidate := `
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	_, _, err = lockDraft(ctx, tx, postId)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE posts SET status = ?, publishAt = NULL, createdAt = ?, updatedAt = ? WHERE postId = ?`
+	_, err = tx.ExecContext(*ctx, query, models.PostStatusPublished, currentTime.Format("2006-01-02 15:04:05"), currentTime.Format("2006-01-02 15:04:05"), postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// lockDraft locks postId for the rest of tx so PublishScheduledPosts cannot
+// publish it concurrently, and returns its status and moderation status.
+// Published posts are rejected.
+func lockDraft(ctx *context.Context, tx *sql.Tx, postId string) (string, string, error) {
+	query := `
 SELECT 
-    p.authorId,
-    p.title,
-	p.content,
-	IFNULL(p.urlImagePost, "") AS urlImagePost
+	p.status,
+	p.moderationStatus
 FROM posts p 
-WHERE p.postId = ?`
+WHERE p.postId = ? AND p.deletedAt IS NULL
+FOR UPDATE`
 
-	var p libModels.Post
-	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, postId)
+	var currentStatus, currentModerationStatus string
+	rows, err := tx.QueryContext(*ctx, query, postId)
 	if err != nil {
-		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return "", "", status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
 	defer rows.Close()
 	if !rows.Next() {
-		return status.Error(codes.NotFound, "post not found")
+		return "", "", status.Error(codes.NotFound, "draft not found")
 	}
 
-	err = rows.Scan(&p.AuthorId, &p.Title, &p.Content, &p.UrlImagePost)
+	err = rows.Scan(&currentStatus, &currentModerationStatus)
 	if err != nil {
-		return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		return "", "", status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
 	}
 
-	if p.AuthorId != userId {
-		return status.Error(codes.PermissionDenied, "user is not authorized to perform this action")
+	if currentStatus == models.PostStatusPublished {
+		return "", "", status.Error(codes.FailedPrecondition, "post is already published")
 	}
 
-	var setParts []string
-	if content != "" && p.Content != content {
-		setParts = append(setParts, fmt.Sprintf("content = '%s'", content))
+	return currentStatus, currentModerationStatus, nil
+}
+
+// draftModerationTransition returns the moderation status to store when a
+// draft currently in current is edited with requested, and whether its
+// automatic hold is released. Only held drafts become visible again: drafts
+// hidden or removed by moderators keep their status.
+func draftModerationTransition(current, requested string) (string, bool) {
+	if requested != models.ModerationStatusVisible {
+		return requested, false
 	}
-	if urlImagePost != "" && p.UrlImagePost != urlImagePost {
-		setParts = append(setParts, fmt.Sprintf("urlImagePost = '%s'", urlImagePost))
+
+	if current != models.ModerationStatusHeld {
+		return "", false
 	}
-	if title != "" && p.Title != title {
-		setParts = append(setParts, fmt.Sprintf("title = '%s'", title))
+
+	return models.ModerationStatusVisible, true
+}
+
+// PublishScheduledPosts publishes every scheduled post due by now and
+// returns their ids.
+func (m *mysqlResource) PublishScheduledPosts(ctx *context.Context, now time.Time) ([]string, error) {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
+	defer tx.Rollback()
 
-	if len(setParts) == 0 {
-		return status.Error(codes.NotFound, "no fields to update")
+	query := `
+SELECT 
+	p.postId
+FROM posts p 
+WHERE p.status = ? AND p.publishAt <= ? AND p.deletedAt IS NULL
+FOR UPDATE`
+
+	rows, err := tx.QueryContext(*ctx, query, models.PostStatusScheduled, now.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	var postIds []string
+	var args []interface{}
+	for rows.Next() {
+		var postId string
+		err = rows.Scan(&postId)
+		if err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		postIds = append(postIds, postId)
+		args = append(args, postId)
+	}
+	rows.Close()
+
+	if len(postIds) == 0 {
+		return postIds, nil
+	}
+
+	updateQuery := fmt.Sprintf(`UPDATE posts SET status = ?, createdAt = publishAt, publishAt = NULL WHERE postId IN (?%s)`, strings.Repeat(", ?", len(args)-1))
+	_, err = tx.ExecContext(*ctx, updateQuery, append([]interface{}{models.PostStatusPublished}, args...)...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	setClause := setParts[0]
-	for i := 1; i < len(setParts); i++ {
-		setClause += ", " + setParts[i]
+	return postIds, nil
+}
+
+func (m *mysqlResource) ReplacePostTags(ctx *context.Context, postId string, tags []string) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
+	defer tx.Rollback()
 
-	updateQuery := fmt.Sprintf(`UPDATE posts SET updatedAt = ?, %s WHERE postId = '%s'`, setClause, postId)
+	err = replacePostTags(ctx, tx, postId, tags)
+	if err != nil {
+		return err
+	}
 
-	_, err = mysql.DB.ExecContext(*ctx, updateQuery, currentTime.Format("2006-01-02 15:04:05"))
+	err = tx.Commit()
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -256,40 +1337,129 @@ WHERE p.postId = ?`
 	return nil
 }
 
-func (m *mysqlResource) DeletePost(ctx *context.Context, postId, userId string) error {
-	queryValidate := `
-SELECT 
-	p.authorId
-FROM posts p 
-WHERE p.postId = ?`
+func replacePostTags(ctx *context.Context, tx *sql.Tx, postId string, tags []string) error {
+	currentTime := time.Now()
 
-	var authorId string
-	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, postId)
+	_, err := tx.ExecContext(*ctx, `DELETE FROM post_tags WHERE postId = ?`, postId)
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	defer rows.Close()
-	if !rows.Next() {
-		return status.Error(codes.NotFound, "post not found")
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var args []interface{}
+	for _, tag := range tags {
+		args = append(args, postId, tag, currentTime.Format("2006-01-02 15:04:05"))
 	}
 
-	err = rows.Scan(&authorId)
+	insertQuery := `INSERT INTO post_tags (postId, tag, createdAt) VALUES (?, ?, ?)` + strings.Repeat(", (?, ?, ?)", len(tags)-1)
+	_, err = tx.ExecContext(*ctx, insertQuery, args...)
 	if err != nil {
-		return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	if authorId != userId {
-		return status.Error(codes.PermissionDenied, "user is not authorized to perform this action")
+	return nil
+}
+
+func (m *mysqlResource) GetAllPostsFromTag(ctx *context.Context, tag, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{tag, models.PostStatusPublished}, visibilityArgs...)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (p.createdAt, p.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
 	}
+	args = append(args, limit)
 
-	deleteQuery := `DELETE FROM posts WHERE postId = ?`
-	_, err = mysql.DB.ExecContext(*ctx, deleteQuery, postId)
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM post_tags pt
+	JOIN posts p ON pt.postId = p.postId
+	JOIN users u ON p.authorId = u.userId
+WHERE pt.tag = ? AND p.status = ? AND p.deletedAt IS NULL AND %s %s
+ORDER BY p.createdAt DESC, p.postId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
 	if err != nil {
-		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	return nil
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (m *mysqlResource) GetTrendingTags(ctx *context.Context, since time.Time, limit int64) ([]*models.TagCount, error) {
+	query := `
+SELECT 
+	pt.tag,
+	COUNT(*) AS postsCount
+FROM post_tags pt
+	JOIN posts p ON pt.postId = p.postId
+WHERE p.createdAt >= ? AND p.status = ? AND p.visibility = ? AND p.moderationStatus = ? AND p.deletedAt IS NULL
+GROUP BY pt.tag
+ORDER BY postsCount DESC, pt.tag ASC
+LIMIT ?`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, since.Format("2006-01-02 15:04:05"), models.PostStatusPublished, models.PostVisibilityPublic, models.ModerationStatusVisible, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	tags := make([]*models.TagCount, 0)
+
+	for rows.Next() {
+		tag := &models.TagCount{}
+		err = rows.Scan(&tag.Tag, &tag.PostsCount)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
 }
 
 func (m *mysqlResource) GetAllLikesFromPost(ctx *context.Context, postId, userId string) (*libModels.PostLikes, error) {
@@ -299,9 +1469,11 @@ func (m *mysqlResource) GetAllLikesFromPost(ctx *context.Context, postId, userId
 SELECT 
     l.userId,
     l.userName,
+	l.type,
 	l.likedAt
 FROM likes l
-WHERE l.postId = ?
+	JOIN posts p ON l.postId = p.postId
+WHERE l.postId = ? AND p.deletedAt IS NULL
 ORDER BY (l.UserId = ?) DESC, l.likedAt DESC`
 	rows, err := mysql.DB.QueryContext(*ctx, query, postId, userId)
 	if err != nil {
@@ -316,6 +1488,7 @@ ORDER BY (l.UserId = ?) DESC, l.likedAt DESC`
 		err = rows.Scan(
 			&like.UserId,
 			&like.UserName,
+			&like.Type,
 			&like.LikedAt,
 		)
 		if err != nil {
@@ -346,6 +1519,7 @@ func (m *mysqlResource) GetAllLikesFromComment(ctx *context.Context, commentId,
 SELECT 
     cl.userId,
     cl.userName,
+	cl.type,
 	cl.likedAt
 FROM comment_likes cl
 WHERE cl.%s = ?
@@ -363,6 +1537,7 @@ ORDER BY (cl.UserId = ?) DESC, cl.likedAt DESC`, foreignId)
 		err = rows.Scan(
 			&like.UserId,
 			&like.UserName,
+			&like.Type,
 			&like.LikedAt,
 		)
 		if err != nil {
@@ -378,12 +1553,50 @@ ORDER BY (cl.UserId = ?) DESC, cl.likedAt DESC`, foreignId)
 	return commentLikes, nil
 }
 
-func (m *mysqlResource) UpdateLikesFromPostOrComments(ctx *context.Context, postId, commentId, userId, likeType string) error {
-	var table string
-	var userName string
-	var foreignId string
+// UpdateLikesFromPostOrComments toggles the reaction of userId: reacting with
+// the current kind removes it, anything else sets it.
+func (m *mysqlResource) UpdateLikesFromPostOrComments(ctx *context.Context, postId, commentId, userId, likeType, reaction string) error {
+	table, foreignId, targetId, err := getLikeTarget(ctx, postId, commentId, likeType)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	l.type
+FROM %s l
+WHERE l.userId = ? AND l.%s = ?`, table, foreignId)
+	rows, err := mysql.DB.QueryContext(*ctx, query, userId, targetId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	var currentReaction string
+	if rows.Next() {
+		err = rows.Scan(&currentReaction)
+	}
+	rows.Close()
+	if err != nil {
+		return status.Error(codes.Internal, "error scanning mysql row: "+err.Error())
+	}
+
+	if currentReaction == reaction {
+		return m.RemoveLike(ctx, postId, commentId, userId, likeType)
+	}
+
+	return m.AddLike(ctx, postId, commentId, userId, likeType, reaction)
+}
+
+// AddLike sets the reaction of userId on a post, comment or reply. Repeating
+// it changes nothing, and reacting with another kind replaces the reaction.
+func (m *mysqlResource) AddLike(ctx *context.Context, postId, commentId, userId, likeType, reaction string) error {
 	currentTime := time.Now()
 
+	table, foreignId, targetId, err := getLikeTarget(ctx, postId, commentId, likeType)
+	if err != nil {
+		return err
+	}
+
 	queryValidateUser := `
 SELECT 
 	u.name
@@ -399,81 +1612,90 @@ WHERE u.userId = ?`
 		return status.Error(codes.NotFound, "user not found")
 	}
 
+	var userName string
 	err = rowUser.Scan(&userName)
 	if err != nil {
 		return status.Error(codes.Internal, "error scanning mysql row: "+err.Error())
 	}
 
-	var isUserAlreadyLiked bool
+	query := fmt.Sprintf(`
+INSERT INTO %s (userId, %s, userName, type, likedAt) VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE likedAt = IF(type = ?, likedAt, ?), type = ?`, table, foreignId)
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
 
-	if likeType == "likeToPost" {
-		table = "likes"
-		postLikes, err := m.GetAllLikesFromPost(ctx, postId, userId)
-		if err != nil {
-			return err
-		}
+	likedAt := currentTime.Format("2006-01-02 15:04:05")
+	result, err := tx.ExecContext(*ctx, query, userId, targetId, userName, reaction, likedAt, reaction, likedAt, reaction)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
 
-		isUserAlreadyLiked = false
-		for _, like := range postLikes.Likes {
-			if like.UserId == userId {
-				isUserAlreadyLiked = true
-				break
-			}
-		}
-	} else if likeType == "likeToComment" {
-		table = "comment_likes"
-		commentLikes, err := m.GetAllLikesFromComment(ctx, commentId, userId)
+	// The upsert affects one row when it inserts and two when it updates.
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if likeType == "likeToPost" && affected == 1 {
+		err = updatePostCounter(ctx, tx, postId, postLikesCounter, 1)
 		if err != nil {
 			return err
 		}
+	}
 
-		isUserAlreadyLiked = false
-		for _, like := range commentLikes.Likes {
-			if like.UserId == userId {
-				isUserAlreadyLiked = true
-				break
-			}
-		}
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
 
-		err = checkIfCommentIsReply(ctx, commentId)
-		if err != nil {
-			foreignId = "commentId"
-		} else {
-			foreignId = "replyId"
-		}
-	} else {
-		return status.Error(codes.InvalidArgument, "invalid like type")
+	return nil
+}
+
+// RemoveLike removes the reaction of userId, if any, from a post, comment or
+// reply.
+func (m *mysqlResource) RemoveLike(ctx *context.Context, postId, commentId, userId, likeType string) error {
+	table, foreignId, targetId, err := getLikeTarget(ctx, postId, commentId, likeType)
+	if err != nil {
+		return err
 	}
 
-	if !isUserAlreadyLiked {
-		if likeType == "likeToPost" {
-			queryInsert := fmt.Sprintf(`INSERT INTO %s (userId, postId, userName, likedAt) VALUES (?, ?, ?, ?)`, table)
-			_, err = mysql.DB.ExecContext(*ctx, queryInsert, userId, postId, userName, currentTime.Format("2006-01-02 15:04:05"))
-		} else {
-			queryInsert := fmt.Sprintf(`INSERT INTO %s (userId, %s, userName, likedAt) VALUES (?, ?, ?, ?)`, table, foreignId)
-			_, err = mysql.DB.ExecContext(*ctx, queryInsert, userId, commentId, userName, currentTime.Format("2006-01-02 15:04:05"))
-		}
-		if err != nil {
-			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
-		}
-	} else {
-		if likeType == "likeToPost" {
-			queryDelete := fmt.Sprintf(`DELETE FROM %s WHERE userId = ? AND postId = ?`, table)
-			_, err = mysql.DB.ExecContext(*ctx, queryDelete, userId, postId)
-		} else {
-			queryDelete := fmt.Sprintf(`DELETE FROM %s WHERE userId = ? AND %s = ?`, table, foreignId)
-			_, err = mysql.DB.ExecContext(*ctx, queryDelete, userId, commentId)
-		}
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE userId = ? AND %s = ?`, table, foreignId)
+	result, err := tx.ExecContext(*ctx, query, userId, targetId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if likeType == "likeToPost" && deleted > 0 {
+		err = updatePostCounter(ctx, tx, postId, postLikesCounter, -deleted)
 		if err != nil {
-			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+			return err
 		}
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
 	return nil
 }
 
-func (m *mysqlResource) GetAllCommentsFromPost(ctx *context.Context, postId, userId string) (*libModels.PostComments, error) {
-	comments := make([]libModels.Comment, 0)
+func (m *mysqlResource) GetAllCommentsFromPost(ctx *context.Context, postId, userId string) (*models.PostComments, error) {
+	comments := make([]models.Comment, 0)
 	var repliesQuantity int64
 
 	query := `
@@ -485,10 +1707,11 @@ SELECT
 	c.createdAt,
 	IFNULL(c.updatedAt, "") AS updatedAt
 FROM comments c 
-WHERE c.postId = ?
+	JOIN posts p ON c.postId = p.postId
+WHERE c.postId = ? AND p.deletedAt IS NULL AND (c.moderationStatus = ? OR c.userId = ?)
 ORDER BY (c.userId = ?) DESC, c.createdAt DESC`
 
-	rows, err := mysql.DB.QueryContext(*ctx, query, postId, userId)
+	rows, err := mysql.DB.QueryContext(*ctx, query, postId, models.ModerationStatusVisible, userId, userId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -496,7 +1719,7 @@ ORDER BY (c.userId = ?) DESC, c.createdAt DESC`
 	defer rows.Close()
 
 	for rows.Next() {
-		var comment libModels.Comment
+		var comment models.Comment
 		err = rows.Scan(
 			&comment.CommentId,
 			&comment.UserId,
@@ -515,7 +1738,7 @@ ORDER BY (c.userId = ?) DESC, c.createdAt DESC`
 			return nil, err
 		}
 
-		commentReplies, repliesFromCommentQuantity, err := getCommentReplies(ctx, comment.CommentId)
+		commentReplies, repliesFromCommentQuantity, err := getCommentReplies(ctx, comment.CommentId, userId)
 		if err != nil {
 			return nil, err
 		}
@@ -534,15 +1757,20 @@ ORDER BY (c.userId = ?) DESC, c.createdAt DESC`
 
 	commentsQuantity := int64(len(comments))
 	commentsQuantity += repliesQuantity
-	result := &libModels.PostComments{
+	result := &models.PostComments{
 		Comments:      comments,
 		CommentsCount: commentsQuantity,
 	}
 
+	err = loadCommentsMentions(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
-func (m *mysqlResource) CreateComment(ctx *context.Context, postId, commentId, userId, content string) error {
+func (m *mysqlResource) CreateComment(ctx *context.Context, postId, commentId, userId, content, moderationStatus string) error {
 	currentTime := time.Now()
 	var userName string
 
@@ -567,9 +1795,27 @@ WHERE u.userId = ?`
 		return status.Error(codes.Internal, "error scanning mysql row: "+err.Error())
 	}
 
-	baseQuery := `INSERT INTO comments (commentId, postId, userId, userName, content, createdAt) VALUES (?, ?, ?, ?, ?, ?)`
-	_, err = mysql.DB.ExecContext(*ctx, baseQuery, commentId, postId, userId, userName, content, currentTime.Format("2006-01-02 15:04:05"))
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	baseQuery := `INSERT INTO comments (commentId, postId, userId, userName, content, moderationStatus, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	_, err = tx.ExecContext(*ctx, baseQuery, commentId, postId, userId, userName, content, moderationStatus, currentTime.Format("2006-01-02 15:04:05"))
+
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
 
+	if moderationStatus == models.ModerationStatusVisible {
+		err = updatePostCounter(ctx, tx, postId, postCommentsCounter, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -577,7 +1823,7 @@ WHERE u.userId = ?`
 	return nil
 }
 
-func (m *mysqlResource) CreateReply(ctx *context.Context, commentId, replyId, userId, content string) error {
+func (m *mysqlResource) CreateReply(ctx *context.Context, commentId, replyId, userId, content, moderationStatus string) error {
 	currentTime := time.Now()
 	var userName string
 
@@ -604,7 +1850,7 @@ WHERE u.userId = ?`
 
 	queryValidateComment := `
 SELECT 
-	c.commentId
+	c.postId
 FROM comments c 
 WHERE c.commentId = ?`
 
@@ -615,56 +1861,79 @@ WHERE c.commentId = ?`
 
 	defer rowComment.Close()
 	if !rowComment.Next() {
-		err = checkIfCommentIsReplyAndInsertNewReplyToReply(ctx, commentId, replyId, userId, userName, content, currentTime.Format("2006-01-02 15:04:05"))
+		err = m.checkIfCommentIsReplyAndInsertNewReplyToReply(ctx, commentId, replyId, userId, userName, content, moderationStatus, currentTime.Format("2006-01-02 15:04:05"))
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 
-	baseQuery := `INSERT INTO comment_replies (commentId, replyId, userId, userName, content, createdAt) VALUES (?, ?, ?, ?, ?, ?)`
-	_, err = mysql.DB.ExecContext(*ctx, baseQuery, commentId, replyId, userId, userName, content, currentTime.Format("2006-01-02 15:04:05"))
+	var postId string
+	err = rowComment.Scan(&postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error scanning mysql row: "+err.Error())
+	}
+
+	baseQuery := `INSERT INTO comment_replies (commentId, replyId, userId, userName, content, moderationStatus, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	return insertReply(ctx, postId, replyId, baseQuery, commentId, replyId, userId, userName, content, moderationStatus, currentTime.Format("2006-01-02 15:04:05"))
+}
+
+// DeleteComment deletes a comment and uncounts it, along with the replies
+// nested under it, from its post. moderationAction, when set, is recorded in
+// the same transaction.
+func (m *mysqlResource) DeleteComment(ctx *context.Context, commentId string, moderationAction *models.ModerationAction) error {
+	target, err := m.GetTarget(ctx, models.MentionTargetComment, commentId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "comment not found")
+		}
+		return err
+	}
 
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
+	defer tx.Rollback()
 
-	return nil
-}
+	counted, err := isCommentCounted(ctx, tx, models.MentionTargetComment, commentId)
+	if err != nil {
+		return err
+	}
 
-func (m *mysqlResource) DeleteComment(ctx *context.Context, commentId, userId string) error {
-	var commentUserId string
+	if counted {
+		err = countComment(ctx, tx, target.PostId, models.MentionTargetComment, commentId, -1)
+		if err != nil {
+			return err
+		}
+	}
 
-	queryValidate := `
-		SELECT 
-			c.userId
-		FROM comments c 
-		WHERE c.commentId = ?`
+	deleteQuery := `DELETE FROM comments WHERE commentId = ?`
+	result, err := tx.ExecContext(*ctx, deleteQuery, commentId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
 
-	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, commentId)
+	deleted, err := result.RowsAffected()
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	defer rows.Close()
-	if !rows.Next() {
+	if deleted == 0 {
 		return status.Error(codes.NotFound, "comment not found")
 	}
 
-	err = rows.Scan(&commentUserId)
+	_, err = tx.ExecContext(*ctx, `DELETE FROM mentions WHERE targetType = ? AND targetId = ?`, models.MentionTargetComment, commentId)
 	if err != nil {
-		return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	if commentUserId != userId {
-		return status.Error(codes.PermissionDenied, "user is not authorized to perform this action")
+	err = recordModerationAction(ctx, tx, moderationAction)
+	if err != nil {
+		return err
 	}
 
-	var deleteQuery string
-
-	deleteQuery = `DELETE FROM comments WHERE commentId = ?`
-	_, err = mysql.DB.ExecContext(*ctx, deleteQuery, commentId)
-
+	err = tx.Commit()
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -672,58 +1941,234 @@ func (m *mysqlResource) DeleteComment(ctx *context.Context, commentId, userId st
 	return nil
 }
 
-func (m *mysqlResource) DeleteReply(ctx *context.Context, replyId, userId string) error {
-	var commentUserId string
-
-	queryValidate := `
-		SELECT 
-			cr.userId
-		FROM comment_replies cr 
-			JOIN comments c ON cr.commentId = c.commentId
-		WHERE cr.replyId = ?`
+// DeleteReply deletes a reply and uncounts it, along with the replies nested
+// under it, from its post. moderationAction, when set, is recorded in the
+// same transaction.
+func (m *mysqlResource) DeleteReply(ctx *context.Context, replyId string, moderationAction *models.ModerationAction) error {
+	target, err := m.GetTarget(ctx, models.MentionTargetReply, replyId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "reply not found")
+		}
+		return err
+	}
 
-	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, replyId)
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
+	defer tx.Rollback()
 
-	defer rows.Close()
-	if !rows.Next() {
-		rows, err = checkInRepliesTable(ctx, replyId)
+	counted, err := isCommentCounted(ctx, tx, models.MentionTargetReply, replyId)
+	if err != nil {
+		return err
+	}
+
+	if counted {
+		err = countComment(ctx, tx, target.PostId, models.MentionTargetReply, replyId, -1)
 		if err != nil {
 			return err
 		}
-		defer rows.Close()
-		if !rows.Next() {
-			return status.Error(codes.NotFound, "reply not found")
+	}
+
+	deleteQuery := `DELETE FROM comment_replies WHERE replyId = ?`
+	result, err := tx.ExecContext(*ctx, deleteQuery, replyId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if deleted == 0 {
+		return status.Error(codes.NotFound, "reply not found")
+	}
+
+	_, err = tx.ExecContext(*ctx, `DELETE FROM mentions WHERE targetType = ? AND targetId = ?`, models.MentionTargetReply, replyId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = recordModerationAction(ctx, tx, moderationAction)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+func buildVisibilityCondition(viewerId string, includeUnlisted bool) (string, []interface{}) {
+	visibilities := []interface{}{models.PostVisibilityPublic}
+	if includeUnlisted {
+		visibilities = append(visibilities, models.PostVisibilityUnlisted)
+	}
+
+	condition := fmt.Sprintf(`(p.authorId = ? OR (p.moderationStatus = ? AND (p.visibility IN (?%s) OR (p.visibility = ? AND EXISTS (
+	SELECT 1 FROM follows f WHERE f.followerId = ? AND f.followingId = p.authorId
+)))))`, strings.Repeat(", ?", len(visibilities)-1))
+	args := append([]interface{}{viewerId, models.ModerationStatusVisible}, visibilities...)
+	args = append(args, models.PostVisibilityFollowers, viewerId)
+
+	return condition, args
+}
+
+func buildPostFiltersConditions(filters *models.PostFilters) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if filters == nil {
+		return conditions, args
+	}
+
+	if len(filters.Types) > 0 {
+		conditions = append(conditions, "p.type IN (?"+strings.Repeat(", ?", len(filters.Types)-1)+")")
+		for _, postType := range filters.Types {
+			args = append(args, postType)
+		}
+	}
+	if len(filters.AuthorIds) > 0 {
+		conditions = append(conditions, "p.authorId IN (?"+strings.Repeat(", ?", len(filters.AuthorIds)-1)+")")
+		for _, authorId := range filters.AuthorIds {
+			args = append(args, authorId)
+		}
+	}
+	if filters.CreatedFrom != nil {
+		conditions = append(conditions, "p.createdAt >= ?")
+		args = append(args, *filters.CreatedFrom)
+	}
+	if filters.CreatedTo != nil {
+		conditions = append(conditions, "p.createdAt <= ?")
+		args = append(args, *filters.CreatedTo)
+	}
+	if filters.UpdatedFrom != nil {
+		conditions = append(conditions, "p.updatedAt >= ?")
+		args = append(args, *filters.UpdatedFrom)
+	}
+	if filters.UpdatedTo != nil {
+		conditions = append(conditions, "p.updatedAt <= ?")
+		args = append(args, *filters.UpdatedTo)
+	}
+	if filters.HasImage != nil {
+		if *filters.HasImage {
+			conditions = append(conditions, "p.urlImagePost IS NOT NULL AND p.urlImagePost <> ''")
+		} else {
+			conditions = append(conditions, "(p.urlImagePost IS NULL OR p.urlImagePost = '')")
 		}
 	}
 
-	err = rows.Scan(&commentUserId)
+	return conditions, args
+}
+
+func loadPostsDetails(ctx *context.Context, posts []*models.Post, viewerId string) error {
+	err := loadPostsContent(ctx, posts)
+	if err != nil {
+		return err
+	}
+
+	err = loadQuotedPosts(ctx, posts, viewerId)
 	if err != nil {
-		return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		return err
+	}
+
+	err = loadPostsBookmarkedByViewer(ctx, posts, viewerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func loadPostsContent(ctx *context.Context, posts []*models.Post) error {
+	err := loadPostsTags(ctx, posts)
+	if err != nil {
+		return err
+	}
+
+	err = loadPostsMentions(ctx, posts)
+	if err != nil {
+		return err
+	}
+
+	err = loadPostsAttachments(ctx, posts)
+	if err != nil {
+		return err
+	}
+
+	err = loadPostsShareCounts(ctx, posts)
+	if err != nil {
+		return err
+	}
+
+	err = loadPostsEngagementCounts(ctx, posts)
+	if err != nil {
+		return err
 	}
 
-	if commentUserId != userId {
-		return status.Error(codes.PermissionDenied, "user is not authorized to perform this action")
+	return nil
+}
+
+func loadPostsTags(ctx *context.Context, posts []*models.Post) error {
+	if len(posts) == 0 {
+		return nil
 	}
 
-	var deleteQuery string
+	postsById := make(map[string]*models.Post, len(posts))
+	args := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		post.Tags = make([]string, 0)
+		postsById[post.PostId] = post
+		args = append(args, post.PostId)
+	}
 
-	deleteQuery = `DELETE FROM comment_replies WHERE replyId = ?`
-	_, err = mysql.DB.ExecContext(*ctx, deleteQuery, replyId)
+	query := fmt.Sprintf(`
+SELECT 
+	pt.postId,
+	pt.tag
+FROM post_tags pt
+WHERE pt.postId IN (?%s)
+ORDER BY pt.tag ASC`, strings.Repeat(", ?", len(args)-1))
 
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
 	if err != nil {
 		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId, tag string
+		err = rows.Scan(&postId, &tag)
+		if err != nil {
+			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		if post, ok := postsById[postId]; ok {
+			post.Tags = append(post.Tags, tag)
+		}
+	}
+
 	return nil
 }
 
-func getCommentReplies(ctx *context.Context, commentId string) (*libModels.PostComments, *int64, error) {
+func formatNullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func getCommentReplies(ctx *context.Context, commentId, viewerId string) (*models.PostComments, *int64, error) {
 	var commentRepliesQuantity int64
 	var replyFromRepliesTotalQuantity int64
-	replies := make([]libModels.Comment, 0)
+	replies := make([]models.Comment, 0)
 
 	query := `
 SELECT 
@@ -734,10 +2179,10 @@ SELECT
 	cr.createdAt,
 	IFNULL(cr.updatedAt, "") AS updatedAt
 FROM comment_replies cr 
-WHERE cr.commentId = ?
+WHERE cr.commentId = ? AND (cr.moderationStatus = ? OR cr.userId = ?)
 ORDER BY cr.createdAt DESC`
 
-	rows, err := mysql.DB.QueryContext(*ctx, query, commentId)
+	rows, err := mysql.DB.QueryContext(*ctx, query, commentId, models.ModerationStatusVisible, viewerId)
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -745,7 +2190,7 @@ ORDER BY cr.createdAt DESC`
 	defer rows.Close()
 
 	for rows.Next() {
-		var reply libModels.Comment
+		var reply models.Comment
 		err = rows.Scan(
 			&reply.CommentId,
 			&reply.UserId,
@@ -764,7 +2209,7 @@ ORDER BY cr.createdAt DESC`
 			return nil, nil, err
 		}
 
-		commentReplies, repliesQuantity, err := getRepliesFromReply(ctx, reply.CommentId)
+		commentReplies, repliesQuantity, err := getRepliesFromReply(ctx, reply.CommentId, viewerId)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -782,7 +2227,7 @@ ORDER BY cr.createdAt DESC`
 	}
 
 	commentRepliesQuantity = int64(len(replies)) + replyFromRepliesTotalQuantity
-	postReplies := &libModels.PostComments{
+	postReplies := &models.PostComments{
 		Comments:      replies,
 		CommentsCount: commentRepliesQuantity,
 	}
@@ -790,10 +2235,10 @@ ORDER BY cr.createdAt DESC`
 	return postReplies, &commentRepliesQuantity, nil
 }
 
-func getRepliesFromReply(ctx *context.Context, replyId string) (*libModels.PostComments, *int64, error) {
+func getRepliesFromReply(ctx *context.Context, replyId, viewerId string) (*models.PostComments, *int64, error) {
 	var commentRepliesQuantity int64
 	var replyFromRepliesTotalQuantity int64
-	replies := make([]libModels.Comment, 0)
+	replies := make([]models.Comment, 0)
 
 	query := `
 SELECT 
@@ -804,10 +2249,10 @@ SELECT
 	cr.createdAt,
 	IFNULL(cr.updatedAt, "") AS updatedAt
 FROM comment_replies cr 
-WHERE cr.parentReplyId = ?
+WHERE cr.parentReplyId = ? AND (cr.moderationStatus = ? OR cr.userId = ?)
 ORDER BY cr.createdAt DESC`
 
-	rows, err := mysql.DB.QueryContext(*ctx, query, replyId)
+	rows, err := mysql.DB.QueryContext(*ctx, query, replyId, models.ModerationStatusVisible, viewerId)
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
@@ -815,7 +2260,7 @@ ORDER BY cr.createdAt DESC`
 	defer rows.Close()
 
 	for rows.Next() {
-		var reply libModels.Comment
+		var reply models.Comment
 		err = rows.Scan(
 			&reply.CommentId,
 			&reply.UserId,
@@ -834,7 +2279,7 @@ ORDER BY cr.createdAt DESC`
 			return nil, nil, err
 		}
 
-		repliesFromReply, repliesQuantity, err := getRepliesFromReply(ctx, reply.CommentId)
+		repliesFromReply, repliesQuantity, err := getRepliesFromReply(ctx, reply.CommentId, viewerId)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -852,7 +2297,7 @@ ORDER BY cr.createdAt DESC`
 	}
 
 	commentRepliesQuantity = int64(len(replies)) + replyFromRepliesTotalQuantity
-	postReplies := &libModels.PostComments{
+	postReplies := &models.PostComments{
 		Comments:      replies,
 		CommentsCount: commentRepliesQuantity,
 	}
@@ -867,6 +2312,7 @@ func getLikesFromComment(ctx *context.Context, commentId, foreignId string) (*li
 SELECT 
 	l.userId,
 	l.userName,
+	l.type,
 	l.likedAt
 FROM comment_likes l
 WHERE l.%s = ?
@@ -883,6 +2329,7 @@ ORDER BY l.likedAt DESC`, foreignId)
 		err = rows.Scan(
 			&like.UserId,
 			&like.UserName,
+			&like.Type,
 			&like.LikedAt,
 		)
 		if err != nil {
@@ -900,6 +2347,28 @@ ORDER BY l.likedAt DESC`, foreignId)
 	return postLikes, nil
 }
 
+// getLikeTarget returns the table, the column and the id a like of likeType
+// is stored under.
+func getLikeTarget(ctx *context.Context, postId, commentId, likeType string) (string, string, string, error) {
+	switch likeType {
+	case "likeToPost":
+		return "likes", "postId", postId, nil
+	case "likeToComment":
+		err := checkIfCommentIsReply(ctx, commentId)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return "", "", "", err
+			}
+
+			return "comment_likes", "commentId", commentId, nil
+		}
+
+		return "comment_likes", "replyId", commentId, nil
+	default:
+		return "", "", "", status.Error(codes.InvalidArgument, "invalid like type")
+	}
+}
+
 func checkIfCommentIsReply(ctx *context.Context, commentId string) error {
 	queryValidateReply := `
 SELECT 
@@ -920,35 +2389,55 @@ WHERE cr.replyId = ?`
 	return nil
 }
 
-func checkIfCommentIsReplyAndInsertNewReplyToReply(ctx *context.Context, commentId, replyId, userId, userName, content, currentTime string) error {
+func (m *mysqlResource) checkIfCommentIsReplyAndInsertNewReplyToReply(ctx *context.Context, commentId, replyId, userId, userName, content, moderationStatus, currentTime string) error {
 	err := checkIfCommentIsReply(ctx, commentId)
 	if err != nil {
 		return err
 	}
 
-	baseQuery := `INSERT INTO comment_replies (parentReplyId, replyId, userId, userName, content, createdAt) VALUES (?, ?, ?, ?, ?, ?)`
-	_, err = mysql.DB.ExecContext(*ctx, baseQuery, commentId, replyId, userId, userName, content, currentTime)
+	parentReply, err := m.GetTarget(ctx, models.MentionTargetReply, commentId)
 	if err != nil {
-		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return err
 	}
 
-	return nil
+	baseQuery := `INSERT INTO comment_replies (parentReplyId, replyId, userId, userName, content, moderationStatus, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	return insertReply(ctx, parentReply.PostId, replyId, baseQuery, commentId, replyId, userId, userName, content, moderationStatus, currentTime)
 }
 
-func checkInRepliesTable(ctx *context.Context, replyId string) (*sql.Rows, error) {
-	queryValidate := `
-		SELECT 
-			cr.userId
-		FROM comment_replies cr 
-		WHERE cr.replyId = ?`
+// insertReply runs the insert of a reply and, when it counts, adds it to the
+// replies of its post in one transaction.
+func insertReply(ctx *context.Context, postId, replyId, query string, args ...interface{}) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
 
-	rows, err := mysql.DB.QueryContext(*ctx, queryValidate, replyId)
+	_, err = tx.ExecContext(*ctx, query, args...)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	counted, err := isCommentCounted(ctx, tx, models.MentionTargetReply, replyId)
+	if err != nil {
+		return err
+	}
+
+	if counted {
+		err = updatePostCounter(ctx, tx, postId, postRepliesCounter, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
 	}
 
-	return rows, nil
+	return nil
 }
+
 func NewMysqlRepository(mysqlClient *mysql.Client) IMySqlPost {
 	return &mysqlResource{
 		mysqlClient: mysqlClient,
@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/relaunch-cot/service-post/models"
+)
+
+func TestDraftModerationTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     string
+		requested   string
+		want        string
+		wantRelease bool
+	}{
+		{"text untouched", models.ModerationStatusHeld, "", "", false},
+		{"held edited clean", models.ModerationStatusHeld, models.ModerationStatusVisible, models.ModerationStatusVisible, true},
+		{"held edited still flagged", models.ModerationStatusHeld, models.ModerationStatusHeld, models.ModerationStatusHeld, false},
+		{"visible edited clean", models.ModerationStatusVisible, models.ModerationStatusVisible, "", false},
+		{"visible edited flagged", models.ModerationStatusVisible, models.ModerationStatusHeld, models.ModerationStatusHeld, false},
+		{"hidden edited clean", models.ModerationStatusHidden, models.ModerationStatusVisible, "", false},
+		{"removed edited clean", models.ModerationStatusRemoved, models.ModerationStatusVisible, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, release := draftModerationTransition(tt.current, tt.requested)
+			if got != tt.want || release != tt.wantRelease {
+				t.Errorf("draftModerationTransition(%q, %q) = %q, %v, want %q, %v", tt.current, tt.requested, got, release, tt.want, tt.wantRelease)
+			}
+		})
+	}
+}
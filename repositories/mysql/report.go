@@ -0,0 +1,80 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *mysqlResource) CreateReport(ctx *context.Context, reportId, targetType, targetId, postId, reporterId, category, details string) (bool, error) {
+	currentTime := time.Now()
+
+	query := `INSERT IGNORE INTO reports (reportId, targetType, targetId, postId, reporterId, category, details, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := mysql.DB.ExecContext(*ctx, query, reportId, targetType, targetId, postId, reporterId, category, details, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	created, err := result.RowsAffected()
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return created > 0, nil
+}
+
+// openReportCondition keeps the reports on r made after the last moderation
+// decision on their target. Reports a moderator already ruled on must not
+// hide the content again.
+const openReportCondition = `NOT EXISTS (
+	SELECT 1 FROM moderation_actions ma 
+	WHERE ma.targetType = r.targetType AND ma.targetId = r.targetId AND ma.createdAt >= r.createdAt
+)`
+
+// CountReports counts the reports on a target made since its last moderation
+// decision.
+func (m *mysqlResource) CountReports(ctx *context.Context, targetType, targetId string) (int64, error) {
+	query := `SELECT COUNT(*) FROM reports r WHERE r.targetType = ? AND r.targetId = ? AND ` + openReportCondition
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, targetType, targetId)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	var reportsCount int64
+	if rows.Next() {
+		err = rows.Scan(&reportsCount)
+		if err != nil {
+			return 0, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+	}
+
+	return reportsCount, nil
+}
+
+// HideContent hides a visible post, comment or reply and reports whether it
+// was changed. Content already held or removed by moderation is left alone.
+func (m *mysqlResource) HideContent(ctx *context.Context, targetType, targetId, postId string) (bool, error) {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	hidden, err := setModerationStatus(ctx, tx, targetType, targetId, postId, models.ModerationStatusHidden, models.ModerationStatusVisible)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return hidden, nil
+}
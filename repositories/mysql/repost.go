@@ -0,0 +1,288 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *mysqlResource) CreateRepost(ctx *context.Context, userId, postId string) error {
+	currentTime := time.Now()
+
+	query := `INSERT IGNORE INTO reposts (userId, postId, createdAt) VALUES (?, ?, ?)`
+	_, err := mysql.DB.ExecContext(*ctx, query, userId, postId, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// DeleteRepost also drops the repost from the timelines it was fanned out to.
+func (m *mysqlResource) DeleteRepost(ctx *context.Context, userId, postId string) error {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(*ctx, `DELETE FROM reposts WHERE userId = ? AND postId = ?`, userId, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	_, err = tx.ExecContext(*ctx, `DELETE FROM timeline_entries WHERE repostedBy = ? AND postId = ?`, userId, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// GetAllRepostsFromUser lists what userId reposted, newest first, embedding
+// each original post. Reposts of posts that were deleted or are hidden from
+// the viewer are left out.
+func (m *mysqlResource) GetAllRepostsFromUser(ctx *context.Context, userId, viewerId string, cursor *pagination.Cursor, limit int64) ([]*models.Repost, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, true)
+	args := append([]interface{}{userId, models.PostStatusPublished}, visibilityArgs...)
+	cursorClause := ""
+	if cursor != nil {
+		cursorClause = "AND (r.createdAt, r.postId) < (?, ?)"
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	r.userId,
+	r.createdAt,
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM reposts r
+	JOIN posts p ON r.postId = p.postId
+	JOIN users u ON p.authorId = u.userId
+WHERE r.userId = ? AND p.status = ? AND p.deletedAt IS NULL AND %s %s
+ORDER BY r.createdAt DESC, r.postId DESC
+LIMIT ?`, visibilityCondition, cursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	reposts := make([]*models.Repost, 0)
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		repost := &models.Repost{Post: &models.Post{}}
+		err = rows.Scan(
+			&repost.UserId,
+			&repost.CreatedAt,
+			&repost.Post.PostId,
+			&repost.Post.AuthorId,
+			&repost.Post.AuthorName,
+			&repost.Post.Title,
+			&repost.Post.Content,
+			&repost.Post.Type,
+			&repost.Post.UrlImagePost,
+			&repost.Post.CreatedAt,
+			&repost.Post.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		reposts = append(reposts, repost)
+		posts = append(posts, repost.Post)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return reposts, nil
+}
+
+// loadQuotedPosts resolves the posts quoted by posts, one level deep, as
+// seen by viewerId.
+func loadQuotedPosts(ctx *context.Context, posts []*models.Post, viewerId string) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		args = append(args, post.PostId)
+	}
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.quotedPostId
+FROM posts p 
+WHERE p.postId IN (?%s) AND p.quotedPostId IS NOT NULL`, strings.Repeat(", ?", len(args)-1))
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	quotedPostIds := make(map[string]string)
+	var quotedArgs []interface{}
+	for rows.Next() {
+		var postId, quotedPostId string
+		err = rows.Scan(&postId, &quotedPostId)
+		if err != nil {
+			rows.Close()
+			return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		quotedPostIds[postId] = quotedPostId
+		quotedArgs = append(quotedArgs, quotedPostId)
+	}
+	rows.Close()
+
+	if len(quotedArgs) == 0 {
+		return nil
+	}
+
+	quotedPostIdsPlaceholders := "?" + strings.Repeat(", ?", len(quotedArgs)-1)
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, true)
+	quotedArgs = append(append(quotedArgs, models.PostStatusPublished), visibilityArgs...)
+
+	quotedQuery := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM posts p 
+	JOIN users u ON p.authorId = u.userId
+WHERE p.postId IN (%s) AND p.status = ? AND p.deletedAt IS NULL AND %s`, quotedPostIdsPlaceholders, visibilityCondition)
+
+	rows, err = mysql.DB.QueryContext(*ctx, quotedQuery, quotedArgs...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	quotedPosts := make([]*models.Post, 0)
+	quotedPostsById := make(map[string]*models.Post)
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		quotedPosts = append(quotedPosts, p)
+		quotedPostsById[p.PostId] = p
+	}
+
+	err = loadPostsContent(ctx, quotedPosts)
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		post.QuotedPostId = quotedPostIds[post.PostId]
+		post.QuotedPost = quotedPostsById[post.QuotedPostId]
+	}
+
+	return nil
+}
+
+func loadPostsShareCounts(ctx *context.Context, posts []*models.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postsById := make(map[string]*models.Post, len(posts))
+	postIds := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		post.RepostsCount, post.QuotesCount = 0, 0
+		postsById[post.PostId] = post
+		postIds = append(postIds, post.PostId)
+	}
+
+	postIdsPlaceholders := "?" + strings.Repeat(", ?", len(postIds)-1)
+	query := fmt.Sprintf(`
+SELECT 
+	shares.postId,
+	SUM(shares.isRepost) AS repostsCount,
+	SUM(shares.isQuote) AS quotesCount
+FROM (
+	SELECT r.postId, 1 AS isRepost, 0 AS isQuote
+	FROM reposts r
+	WHERE r.postId IN (%s)
+	UNION ALL
+	SELECT p.quotedPostId, 0 AS isRepost, 1 AS isQuote
+	FROM posts p
+	WHERE p.quotedPostId IN (%s) AND p.status = ? AND p.deletedAt IS NULL
+) shares
+GROUP BY shares.postId`, postIdsPlaceholders, postIdsPlaceholders)
+
+	args := append(append(append([]interface{}{}, postIds...), postIds...), models.PostStatusPublished)
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var postId string
+		var repostsCount, quotesCount int64
+		err = rows.Scan(&postId, &repostsCount, &quotesCount)
+		if err != nil {
+			return status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		if post, ok := postsById[postId]; ok {
+			post.RepostsCount = repostsCount
+			post.QuotesCount = quotesCount
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTarget resolves the owner and post of a post, comment or reply. It
+// ignores status, visibility and moderation so it can back authorization
+// checks for any target, including soft deleted posts.
+func (m *mysqlResource) GetTarget(ctx *context.Context, targetType, targetId string) (*models.ContentTarget, error) {
+	var query string
+	switch targetType {
+	case models.MentionTargetPost:
+		query = `
+SELECT 
+	p.postId,
+	p.authorId,
+	p.authorId,
+	p.deletedAt IS NOT NULL AS postDeleted
+FROM posts p 
+WHERE p.postId = ?`
+	case models.MentionTargetComment:
+		query = `
+SELECT 
+	c.postId,
+	c.userId,
+	p.authorId,
+	p.deletedAt IS NOT NULL AS postDeleted
+FROM comments c 
+	JOIN posts p ON c.postId = p.postId
+WHERE c.commentId = ?`
+	case models.MentionTargetReply:
+		query = `
+WITH RECURSIVE reply_ancestors AS (
+	SELECT cr.replyId, cr.userId, cr.commentId, cr.parentReplyId
+	FROM comment_replies cr
+	WHERE cr.replyId = ?
+	UNION ALL
+	SELECT cr.replyId, a.userId, cr.commentId, cr.parentReplyId
+	FROM comment_replies cr
+		JOIN reply_ancestors a ON cr.replyId = a.parentReplyId
+)
+SELECT 
+	c.postId,
+	a.userId,
+	p.authorId,
+	p.deletedAt IS NOT NULL AS postDeleted
+FROM reply_ancestors a 
+	JOIN comments c ON a.commentId = c.commentId
+	JOIN posts p ON c.postId = p.postId`
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid target type")
+	}
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, targetId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, status.Error(codes.NotFound, targetType+" not found")
+	}
+
+	target := &models.ContentTarget{}
+	err = rows.Scan(&target.PostId, &target.OwnerId, &target.PostAuthorId, &target.PostDeleted)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+	}
+
+	return target, nil
+}
+
+func (m *mysqlResource) GetUserRoles(ctx *context.Context, userId string) ([]string, error) {
+	query := `SELECT ur.role FROM user_roles ur WHERE ur.userId = ?`
+	rows, err := mysql.DB.QueryContext(*ctx, query, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	var roles []string
+	for rows.Next() {
+		var role string
+		err = rows.Scan(&role)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
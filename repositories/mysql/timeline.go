@@ -0,0 +1,348 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FanOutPost copies a published post into the timeline of every follower of
+// its author. Authors with maxFollowers followers or more are marked as pull
+// authors instead, and their posts are read from posts when timelines are
+// loaded. Once marked, an author stays a pull author so that no timeline
+// loses their earlier posts.
+func (m *mysqlResource) FanOutPost(ctx *context.Context, postId string, maxFollowers int64) (int64, error) {
+	query := `
+SELECT 
+	p.authorId
+FROM posts p 
+WHERE p.postId = ? AND p.status = ? AND p.deletedAt IS NULL`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, postId, models.PostStatusPublished)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	if !rows.Next() {
+		rows.Close()
+		return 0, nil
+	}
+
+	var authorId string
+	err = rows.Scan(&authorId)
+	rows.Close()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+	}
+
+	isPullAuthor, err := markPullAuthor(ctx, authorId, maxFollowers)
+	if err != nil || isPullAuthor {
+		return 0, err
+	}
+
+	queryFanOut := `
+INSERT IGNORE INTO timeline_entries (userId, postId, authorId, createdAt)
+SELECT 
+	f.followerId,
+	p.postId,
+	p.authorId,
+	p.createdAt
+FROM posts p 
+	JOIN follows f ON f.followingId = p.authorId
+WHERE p.postId = ? AND p.status = ? AND p.deletedAt IS NULL`
+
+	result, err := mysql.DB.ExecContext(*ctx, queryFanOut, postId, models.PostStatusPublished)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return inserted, nil
+}
+
+// FanOutRepost copies a post reposted by userId into the timeline of every
+// follower of userId, dated at the repost. Followers of the post's author
+// already got the post itself and are skipped. Reposts by pull authors are
+// read from reposts when timelines are loaded, like their posts.
+func (m *mysqlResource) FanOutRepost(ctx *context.Context, userId, postId string, maxFollowers int64) (int64, error) {
+	isPullAuthor, err := markPullAuthor(ctx, userId, maxFollowers)
+	if err != nil || isPullAuthor {
+		return 0, err
+	}
+
+	queryFanOut := `
+INSERT IGNORE INTO timeline_entries (userId, postId, authorId, createdAt, repostedBy)
+SELECT 
+	f.followerId,
+	p.postId,
+	p.authorId,
+	r.createdAt,
+	r.userId
+FROM reposts r
+	JOIN posts p ON r.postId = p.postId
+	JOIN follows f ON f.followingId = r.userId
+WHERE r.userId = ? AND r.postId = ? AND p.status = ? AND p.deletedAt IS NULL AND f.followerId <> p.authorId
+	AND NOT EXISTS (SELECT 1 FROM follows fa WHERE fa.followerId = f.followerId AND fa.followingId = p.authorId)`
+
+	result, err := mysql.DB.ExecContext(*ctx, queryFanOut, userId, postId, models.PostStatusPublished)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return inserted, nil
+}
+
+// markPullAuthor reports whether authorId is a pull author, marking them as
+// one when they reached maxFollowers followers.
+func markPullAuthor(ctx *context.Context, authorId string, maxFollowers int64) (bool, error) {
+	currentTime := time.Now()
+
+	query := `
+SELECT 
+	(SELECT COUNT(*) FROM follows f WHERE f.followingId = ?) AS followersCount,
+	EXISTS (SELECT 1 FROM timeline_pull_authors ta WHERE ta.authorId = ?) AS isPullAuthor`
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, authorId, authorId)
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	var followersCount int64
+	var isPullAuthor bool
+	rows.Next()
+	err = rows.Scan(&followersCount, &isPullAuthor)
+	rows.Close()
+	if err != nil {
+		return false, status.Error(codes.Internal, "error scanning mysql rows. Details: "+err.Error())
+	}
+
+	if isPullAuthor {
+		return true, nil
+	}
+
+	if followersCount < maxFollowers {
+		return false, nil
+	}
+
+	queryPullAuthor := `INSERT IGNORE INTO timeline_pull_authors (authorId, createdAt) VALUES (?, ?)`
+	_, err = mysql.DB.ExecContext(*ctx, queryPullAuthor, authorId, currentTime.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return false, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return true, nil
+}
+
+// BackfillTimeline copies the latest limit posts of authorId into the
+// timeline of userId, as long as userId still follows them. Pull authors are
+// skipped since their posts are read from posts anyway.
+func (m *mysqlResource) BackfillTimeline(ctx *context.Context, userId, authorId string, limit int64) (int64, error) {
+	query := `
+INSERT IGNORE INTO timeline_entries (userId, postId, authorId, createdAt)
+SELECT 
+	f.followerId,
+	p.postId,
+	p.authorId,
+	p.createdAt
+FROM posts p 
+	JOIN follows f ON f.followingId = p.authorId AND f.followerId = ?
+WHERE p.authorId = ? AND p.status = ? AND p.deletedAt IS NULL
+	AND NOT EXISTS (SELECT 1 FROM timeline_pull_authors ta WHERE ta.authorId = p.authorId)
+ORDER BY p.createdAt DESC, p.postId DESC
+LIMIT ?`
+
+	result, err := mysql.DB.ExecContext(*ctx, query, userId, authorId, models.PostStatusPublished, limit)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return inserted, nil
+}
+
+func (m *mysqlResource) DeleteTimelineEntries(ctx *context.Context, postId string) error {
+	query := `DELETE FROM timeline_entries WHERE postId = ?`
+	_, err := mysql.DB.ExecContext(*ctx, query, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// AddTimelinePullPost marks a post whose fan-out could not be queued, so
+// timelines read it from posts like the posts of pull authors.
+func (m *mysqlResource) AddTimelinePullPost(ctx *context.Context, postId string) error {
+	query := `
+INSERT IGNORE INTO timeline_pull_posts (postId, authorId, createdAt)
+SELECT 
+	p.postId,
+	p.authorId,
+	p.createdAt
+FROM posts p 
+WHERE p.postId = ?`
+	_, err := mysql.DB.ExecContext(*ctx, query, postId)
+	if err != nil {
+		return status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return nil
+}
+
+// GetHomeTimeline lists posts for userId's home timeline, newest first by
+// the time they reached it: when they were posted, or when a followed user
+// reposted them. It merges five sources, each cut to the page size before
+// merging: the timeline entries fanned out to userId, userId's own posts,
+// the posts of followed pull authors, followed posts whose fan-out was
+// skipped and the reposts of followed pull authors.
+func (m *mysqlResource) GetHomeTimeline(ctx *context.Context, userId string, cursor *pagination.Cursor, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(userId, true)
+	postCondition := "p.status = ? AND p.deletedAt IS NULL AND " + visibilityCondition
+	postArgs := append([]interface{}{models.PostStatusPublished}, visibilityArgs...)
+
+	entriesCursorClause, postsCursorClause, repostsCursorClause := "", "", ""
+	var cursorArgs []interface{}
+	if cursor != nil {
+		entriesCursorClause = "AND (te.createdAt, te.postId) < (?, ?)"
+		postsCursorClause = "AND (p.createdAt, p.postId) < (?, ?)"
+		repostsCursorClause = "HAVING (MIN(r.createdAt), r.postId) < (?, ?)"
+		cursorArgs = []interface{}{cursor.CreatedAt, cursor.Id}
+	}
+
+	sourceArgs := append(append(append([]interface{}{userId}, postArgs...), cursorArgs...), limit)
+	args := append(append(append(append(append(append([]interface{}{}, sourceArgs...), sourceArgs...), sourceArgs...), sourceArgs...), sourceArgs...), limit)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt,
+	IFNULL(timeline.repostedBy, "") AS repostedBy,
+	timeline.timelineAt
+FROM (
+	(
+		SELECT p.postId, te.createdAt AS timelineAt, te.repostedBy
+		FROM timeline_entries te
+			JOIN posts p ON te.postId = p.postId
+		WHERE te.userId = ? AND %[1]s %[2]s
+		ORDER BY te.createdAt DESC, te.postId DESC
+		LIMIT ?
+	)
+	UNION
+	(
+		SELECT p.postId, p.createdAt AS timelineAt, NULL AS repostedBy
+		FROM posts p
+		WHERE p.authorId = ? AND %[1]s %[3]s
+		ORDER BY p.createdAt DESC, p.postId DESC
+		LIMIT ?
+	)
+	UNION
+	(
+		SELECT p.postId, p.createdAt AS timelineAt, NULL AS repostedBy
+		FROM follows f
+			JOIN timeline_pull_authors ta ON f.followingId = ta.authorId
+			JOIN posts p ON p.authorId = f.followingId
+		WHERE f.followerId = ? AND %[1]s %[3]s
+		ORDER BY p.createdAt DESC, p.postId DESC
+		LIMIT ?
+	)
+	UNION
+	(
+		SELECT p.postId, p.createdAt AS timelineAt, NULL AS repostedBy
+		FROM follows f
+			JOIN timeline_pull_posts tp ON f.followingId = tp.authorId
+			JOIN posts p ON tp.postId = p.postId
+		WHERE f.followerId = ? AND %[1]s %[3]s
+		ORDER BY p.createdAt DESC, p.postId DESC
+		LIMIT ?
+	)
+	UNION
+	(
+		SELECT r.postId, MIN(r.createdAt) AS timelineAt, SUBSTRING_INDEX(GROUP_CONCAT(r.userId ORDER BY r.createdAt, r.userId), ',', 1) AS repostedBy
+		FROM follows f
+			JOIN timeline_pull_authors ta ON f.followingId = ta.authorId
+			JOIN reposts r ON r.userId = f.followingId
+			JOIN posts p ON r.postId = p.postId
+		WHERE f.followerId = ? AND %[1]s AND p.authorId <> f.followerId
+			AND NOT EXISTS (SELECT 1 FROM follows fa WHERE fa.followerId = f.followerId AND fa.followingId = p.authorId)
+			AND NOT EXISTS (SELECT 1 FROM timeline_entries te WHERE te.userId = f.followerId AND te.postId = r.postId)
+		GROUP BY r.postId
+		%[4]s
+		ORDER BY timelineAt DESC, r.postId DESC
+		LIMIT ?
+	)
+) timeline
+	JOIN posts p ON timeline.postId = p.postId
+	JOIN users u ON p.authorId = u.userId
+ORDER BY timeline.timelineAt DESC, timeline.postId DESC
+LIMIT ?`, postCondition, entriesCursorClause, postsCursorClause, repostsCursorClause)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		var timelineAt string
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&p.RepostedBy,
+			&timelineAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		if p.RepostedBy != "" {
+			p.RepostedAt = timelineAt
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
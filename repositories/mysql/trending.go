@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/relaunch-cot/lib-relaunch-cot/repositories/mysql"
+	"github.com/relaunch-cot/service-post/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecomputePostScores replaces post_scores with the trending score of every
+// post published since `since`. A post scores its likes plus commentWeight
+// per counted comment or reply, divided by (age in hours + 2) ^ gravity. It
+// reads the engagement counters kept on posts rather than recounting.
+func (m *mysqlResource) RecomputePostScores(ctx *context.Context, now, since time.Time, commentWeight, gravity float64) (int64, error) {
+	tx, err := mysql.DB.BeginTx(*ctx, nil)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(*ctx, `DELETE FROM post_scores`)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	query := `
+INSERT INTO post_scores (postId, score, computedAt)
+SELECT 
+	p.postId,
+	(p.likesCount + ? * (p.commentsCount + p.repliesCount))
+		/ POW(GREATEST(TIMESTAMPDIFF(SECOND, p.createdAt, ?), 0) / 3600 + 2, ?) AS score,
+	?
+FROM posts p 
+WHERE p.createdAt >= ? AND p.status = ? AND p.moderationStatus = ? AND p.deletedAt IS NULL`
+
+	formattedNow := now.Format("2006-01-02 15:04:05")
+	formattedSince := since.Format("2006-01-02 15:04:05")
+	result, err := tx.ExecContext(*ctx, query,
+		commentWeight, formattedNow, gravity, formattedNow,
+		formattedSince, models.PostStatusPublished, models.ModerationStatusVisible,
+	)
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	scored, err := result.RowsAffected()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	return scored, nil
+}
+
+// GetTrendingPosts lists scored posts, highest score first, as of the last
+// recompute. Posts are paged by offset because scores are not stable keys.
+func (m *mysqlResource) GetTrendingPosts(ctx *context.Context, viewerId string, offset, limit int64) ([]*models.Post, error) {
+	visibilityCondition, visibilityArgs := buildVisibilityCondition(viewerId, false)
+	args := append([]interface{}{models.PostStatusPublished}, visibilityArgs...)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf(`
+SELECT 
+	p.postId,
+	p.authorId,
+	u.name,
+	p.title,
+	p.content,
+	p.type,
+	IFNULL(p.urlImagePost, "") AS urlImagePost,
+	p.createdAt, 
+	IFNULL(p.updatedAt, "") AS updatedAt
+FROM post_scores ps
+	JOIN posts p ON ps.postId = p.postId
+	JOIN users u ON p.authorId = u.userId
+WHERE p.status = ? AND p.deletedAt IS NULL AND %s
+ORDER BY ps.score DESC, p.postId DESC
+LIMIT ? OFFSET ?`, visibilityCondition)
+
+	rows, err := mysql.DB.QueryContext(*ctx, query, args...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+	}
+
+	defer rows.Close()
+
+	posts := make([]*models.Post, 0)
+
+	for rows.Next() {
+		p := &models.Post{}
+		err = rows.Scan(
+			&p.PostId,
+			&p.AuthorId,
+			&p.AuthorName,
+			&p.Title,
+			&p.Content,
+			&p.Type,
+			&p.UrlImagePost,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error with database. Details: "+err.Error())
+		}
+
+		posts = append(posts, p)
+	}
+
+	err = loadPostsDetails(ctx, posts, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
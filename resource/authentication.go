@@ -0,0 +1,27 @@
+package resource
+
+import (
+	"log"
+	"os"
+
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/resource/authentication"
+)
+
+func LoadTokenVerifier() *authentication.Verifier {
+	var rsaPublicKeyPEM []byte
+	if config.JWT_RSA_PUBLIC_KEY_FILE != "" {
+		var err error
+		rsaPublicKeyPEM, err = os.ReadFile(config.JWT_RSA_PUBLIC_KEY_FILE)
+		if err != nil {
+			log.Fatal("failed to read jwt public key: ", err)
+		}
+	}
+
+	verifier, err := authentication.NewVerifier(config.JWT_HMAC_SECRET, rsaPublicKeyPEM, config.JWT_ISSUER, config.JWT_AUDIENCE)
+	if err != nil {
+		log.Fatal("failed to load token verifier: ", err)
+	}
+
+	return verifier
+}
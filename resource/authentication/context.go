@@ -0,0 +1,16 @@
+package authentication
+
+import "context"
+
+type userIdContextKey struct{}
+
+func ContextWithUserId(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIdContextKey{}, userId)
+}
+
+// UserIdFromContext returns the authenticated caller, or "" for anonymous
+// requests.
+func UserIdFromContext(ctx context.Context) string {
+	userId, _ := ctx.Value(userIdContextKey{}).(string)
+	return userId
+}
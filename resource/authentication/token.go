@@ -0,0 +1,219 @@
+package authentication
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const clockSkewLeeway = 30 * time.Second
+
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+}
+
+// audience accepts both forms of the JWT "aud" claim, a single string or an
+// array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if json.Unmarshal(b, &single) == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	err := json.Unmarshal(b, &multiple)
+	if err != nil {
+		return err
+	}
+
+	*a = multiple
+	return nil
+}
+
+// Verifier validates HS256 and RS256 signed JWTs. An algorithm is only
+// accepted when its key is configured.
+type Verifier struct {
+	hmacSecret   []byte
+	rsaPublicKey *rsa.PublicKey
+	issuer       string
+	audience     string
+}
+
+func NewVerifier(hmacSecret string, rsaPublicKeyPEM []byte, issuer, audience string) (*Verifier, error) {
+	verifier := &Verifier{
+		issuer:   issuer,
+		audience: audience,
+	}
+
+	if hmacSecret != "" {
+		verifier.hmacSecret = []byte(hmacSecret)
+	}
+
+	if len(rsaPublicKeyPEM) > 0 {
+		publicKey, err := parseRSAPublicKey(rsaPublicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		verifier.rsaPublicKey = publicKey
+	}
+
+	if verifier.hmacSecret == nil && verifier.rsaPublicKey == nil {
+		return nil, errors.New("no token signing key configured")
+	}
+
+	return verifier, nil
+}
+
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, status.Error(codes.Unauthenticated, "malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	err := decodeSegment(parts[0], &header)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed token header")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed token signature")
+	}
+
+	err = v.verifySignature(header.Alg, parts[0]+"."+parts[1], signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed token claims")
+	}
+
+	err = v.validateClaims(&claims, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *Verifier) verifySignature(alg, signingInput string, signature []byte) error {
+	switch alg {
+	case "HS256":
+		if v.hmacSecret == nil {
+			break
+		}
+
+		mac := hmac.New(sha256.New, v.hmacSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return status.Error(codes.Unauthenticated, "invalid token signature")
+		}
+
+		return nil
+	case "RS256":
+		if v.rsaPublicKey == nil {
+			break
+		}
+
+		hashed := sha256.Sum256([]byte(signingInput))
+		err := rsa.VerifyPKCS1v15(v.rsaPublicKey, crypto.SHA256, hashed[:], signature)
+		if err != nil {
+			return status.Error(codes.Unauthenticated, "invalid token signature")
+		}
+
+		return nil
+	}
+
+	return status.Error(codes.Unauthenticated, "unsupported token algorithm")
+}
+
+func (v *Verifier) validateClaims(claims *Claims, now time.Time) error {
+	if claims.Subject == "" {
+		return status.Error(codes.Unauthenticated, "token has no subject")
+	}
+
+	if claims.ExpiresAt == 0 || now.Add(-clockSkewLeeway).Unix() >= claims.ExpiresAt {
+		return status.Error(codes.Unauthenticated, "token has expired")
+	}
+
+	if claims.NotBefore != 0 && now.Add(clockSkewLeeway).Unix() < claims.NotBefore {
+		return status.Error(codes.Unauthenticated, "token is not valid yet")
+	}
+
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return status.Error(codes.Unauthenticated, "invalid token issuer")
+	}
+
+	if v.audience != "" && !claims.Audience.contains(v.audience) {
+		return status.Error(codes.Unauthenticated, "invalid token audience")
+	}
+
+	return nil
+}
+
+func (a audience) contains(value string) bool {
+	for _, item := range a {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+func parseRSAPublicKey(publicKeyPEM []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("invalid RSA public key PEM")
+	}
+
+	if publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return publicKey, nil
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an RSA key")
+	}
+
+	return rsaPublicKey, nil
+}
@@ -0,0 +1,170 @@
+package authentication
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testSecret   = "test-secret"
+	testIssuer   = "service-user"
+	testAudience = "service-post"
+)
+
+func TestVerifierVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+	rsaPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey),
+	})
+
+	hmacVerifier, err := NewVerifier(testSecret, nil, testIssuer, testAudience)
+	if err != nil {
+		t.Fatalf("NewVerifier() with HMAC secret: %v", err)
+	}
+
+	rsaVerifier, err := NewVerifier("", rsaPEM, testIssuer, testAudience)
+	if err != nil {
+		t.Fatalf("NewVerifier() with RSA key: %v", err)
+	}
+
+	now := time.Now()
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub": "user-1",
+			"iss": testIssuer,
+			"aud": testAudience,
+			"exp": now.Add(time.Hour).Unix(),
+		}
+	}
+	withClaim := func(key string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		token    string
+		wantErr  bool
+	}{
+		{"valid HS256", hmacVerifier, signHS256(t, validClaims(), testSecret), false},
+		{"valid RS256", rsaVerifier, signRS256(t, validClaims(), rsaKey), false},
+		{"audience array", hmacVerifier, signHS256(t, withClaim("aud", []string{"other", testAudience}), testSecret), false},
+		{"expired within leeway", hmacVerifier, signHS256(t, withClaim("exp", now.Add(-10*time.Second).Unix()), testSecret), false},
+
+		{"RS256 against HMAC key", hmacVerifier, signRS256(t, validClaims(), rsaKey), true},
+		{"HS256 against RSA key", rsaVerifier, signHS256(t, validClaims(), testSecret), true},
+		{"none algorithm", hmacVerifier, signToken(t, "none", validClaims(), nil), true},
+		{"bad HS256 signature", hmacVerifier, signHS256(t, validClaims(), "other-secret"), true},
+		{"tampered RS256 signature", rsaVerifier, tamperSignature(signRS256(t, validClaims(), rsaKey)), true},
+		{"expired", hmacVerifier, signHS256(t, withClaim("exp", now.Add(-time.Hour).Unix()), testSecret), true},
+		{"missing exp", hmacVerifier, signHS256(t, withClaim("exp", nil), testSecret), true},
+		{"not valid yet", hmacVerifier, signHS256(t, withClaim("nbf", now.Add(time.Hour).Unix()), testSecret), true},
+		{"missing sub", hmacVerifier, signHS256(t, withClaim("sub", nil), testSecret), true},
+		{"empty sub", hmacVerifier, signHS256(t, withClaim("sub", ""), testSecret), true},
+		{"wrong issuer", hmacVerifier, signHS256(t, withClaim("iss", "other"), testSecret), true},
+		{"wrong audience", hmacVerifier, signHS256(t, withClaim("aud", "other"), testSecret), true},
+		{"malformed", hmacVerifier, "not-a-token", true},
+		{"malformed header", hmacVerifier, "%%%.e30.c2ln", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := tt.verifier.Verify(tt.token)
+			if tt.wantErr {
+				if status.Code(err) != codes.Unauthenticated {
+					t.Fatalf("Verify() error = %v, want Unauthenticated", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Verify() error = %v, want nil", err)
+			}
+			if claims.Subject != "user-1" {
+				t.Errorf("Verify() subject = %q, want %q", claims.Subject, "user-1")
+			}
+		})
+	}
+}
+
+func TestNewVerifierRequiresKey(t *testing.T) {
+	_, err := NewVerifier("", nil, testIssuer, testAudience)
+	if err == nil {
+		t.Error("NewVerifier() without keys returned nil error")
+	}
+}
+
+func signHS256(t *testing.T, claims map[string]interface{}, secret string) string {
+	return signToken(t, "HS256", claims, func(signingInput []byte) []byte {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(signingInput)
+		return mac.Sum(nil)
+	})
+}
+
+func signRS256(t *testing.T, claims map[string]interface{}, key *rsa.PrivateKey) string {
+	return signToken(t, "RS256", claims, func(signingInput []byte) []byte {
+		hashed := sha256.Sum256(signingInput)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+		if err != nil {
+			t.Fatalf("signing token: %v", err)
+		}
+		return signature
+	})
+}
+
+func signToken(t *testing.T, alg string, claims map[string]interface{}, sign func(signingInput []byte) []byte) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	if err != nil {
+		t.Fatalf("encoding token header: %v", err)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("encoding token claims: %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	var signature []byte
+	if sign != nil {
+		signature = sign([]byte(signingInput))
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func tamperSignature(token string) string {
+	b := []byte(token)
+	last := len(b) - 2
+	if b[last] == 'A' {
+		b[last] = 'B'
+	} else {
+		b[last] = 'A'
+	}
+
+	return string(b)
+}
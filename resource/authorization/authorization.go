@@ -0,0 +1,100 @@
+package authorization
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+type Action string
+
+const (
+	ActionUpdatePost      Action = "post:update"
+	ActionDeletePost      Action = "post:delete"
+	ActionPinPost         Action = "post:pin"
+	ActionRestorePost     Action = "post:restore"
+	ActionUpdateDraft     Action = "draft:update"
+	ActionDeleteComment   Action = "comment:delete"
+	ActionModerateContent Action = "content:moderate"
+)
+
+// Subject is the user performing an action. Every subject implicitly has
+// RoleUser.
+type Subject struct {
+	UserId string
+	Roles  []Role
+}
+
+// Resource describes the content an action targets. Both fields are empty
+// for actions that do not target a specific post, comment or reply.
+type Resource struct {
+	OwnerId      string
+	PostAuthorId string
+}
+
+// Policy grants an action when it returns true.
+type Policy func(subject Subject, resource Resource) bool
+
+type Authorizer struct {
+	policies map[Action][]Policy
+}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{
+		policies: defaultPolicies(),
+	}
+}
+
+func NewSubject(userId string, roles []string) Subject {
+	subject := Subject{
+		UserId: userId,
+		Roles:  []Role{RoleUser},
+	}
+	for _, role := range roles {
+		subject.Roles = append(subject.Roles, Role(role))
+	}
+
+	return subject
+}
+
+func (s Subject) HasRole(role Role) bool {
+	for _, subjectRole := range s.Roles {
+		if subjectRole == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsStaff reports whether the subject acts on behalf of the platform rather
+// than as a regular user.
+func (s Subject) IsStaff() bool {
+	return s.HasRole(RoleModerator) || s.HasRole(RoleAdmin)
+}
+
+// Can reports whether any policy declared for action grants it.
+func (a *Authorizer) Can(subject Subject, action Action, resource Resource) bool {
+	for _, policy := range a.policies[action] {
+		if policy(subject, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Authorizer) Authorize(subject Subject, action Action, resource Resource) error {
+	if !a.Can(subject, action, resource) {
+		return status.Error(codes.PermissionDenied, "user is not authorized to perform this action")
+	}
+
+	return nil
+}
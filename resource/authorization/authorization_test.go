@@ -0,0 +1,100 @@
+package authorization
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizerCan(t *testing.T) {
+	const (
+		ownerId      = "owner"
+		postAuthorId = "post-author"
+		otherId      = "other"
+	)
+
+	user := NewSubject(otherId, nil)
+	owner := NewSubject(ownerId, nil)
+	postAuthor := NewSubject(postAuthorId, nil)
+	moderator := NewSubject(otherId, []string{string(RoleModerator)})
+	admin := NewSubject(otherId, []string{string(RoleAdmin)})
+	anonymous := NewSubject("", nil)
+
+	ownedPost := Resource{OwnerId: ownerId}
+	ownedComment := Resource{OwnerId: ownerId, PostAuthorId: postAuthorId}
+
+	tests := []struct {
+		name     string
+		subject  Subject
+		action   Action
+		resource Resource
+		want     bool
+	}{
+		{"owner updates post", owner, ActionUpdatePost, ownedPost, true},
+		{"user updates post", user, ActionUpdatePost, ownedPost, false},
+		{"moderator updates post", moderator, ActionUpdatePost, ownedPost, false},
+		{"admin updates post", admin, ActionUpdatePost, ownedPost, true},
+
+		{"owner deletes post", owner, ActionDeletePost, ownedPost, true},
+		{"user deletes post", user, ActionDeletePost, ownedPost, false},
+		{"moderator deletes post", moderator, ActionDeletePost, ownedPost, true},
+		{"admin deletes post", admin, ActionDeletePost, ownedPost, true},
+
+		{"owner pins post", owner, ActionPinPost, ownedPost, true},
+		{"user pins post", user, ActionPinPost, ownedPost, false},
+		{"moderator pins post", moderator, ActionPinPost, ownedPost, false},
+		{"admin pins post", admin, ActionPinPost, ownedPost, false},
+
+		{"owner restores post", owner, ActionRestorePost, ownedPost, true},
+		{"user restores post", user, ActionRestorePost, ownedPost, false},
+		{"moderator restores post", moderator, ActionRestorePost, ownedPost, false},
+		{"admin restores post", admin, ActionRestorePost, ownedPost, true},
+
+		{"owner updates draft", owner, ActionUpdateDraft, ownedPost, true},
+		{"user updates draft", user, ActionUpdateDraft, ownedPost, false},
+		{"moderator updates draft", moderator, ActionUpdateDraft, ownedPost, false},
+		{"admin updates draft", admin, ActionUpdateDraft, ownedPost, false},
+
+		{"owner deletes comment", owner, ActionDeleteComment, ownedComment, true},
+		{"post author deletes comment", postAuthor, ActionDeleteComment, ownedComment, true},
+		{"user deletes comment", user, ActionDeleteComment, ownedComment, false},
+		{"moderator deletes comment", moderator, ActionDeleteComment, ownedComment, true},
+		{"admin deletes comment", admin, ActionDeleteComment, ownedComment, true},
+
+		{"user moderates content", user, ActionModerateContent, Resource{}, false},
+		{"owner moderates own content", owner, ActionModerateContent, ownedPost, false},
+		{"moderator moderates content", moderator, ActionModerateContent, Resource{}, true},
+		{"admin moderates content", admin, ActionModerateContent, Resource{}, true},
+
+		{"anonymous never owns unowned resource", anonymous, ActionUpdatePost, Resource{}, false},
+		{"anonymous never authors unowned post", anonymous, ActionDeleteComment, Resource{}, false},
+		{"unknown action", admin, Action("post:unknown"), ownedPost, false},
+		{"unknown role", NewSubject(otherId, []string{"editor"}), ActionDeletePost, ownedPost, false},
+	}
+
+	authorizer := NewAuthorizer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authorizer.Can(tt.subject, tt.action, tt.resource)
+			if got != tt.want {
+				t.Errorf("Can(%v, %q, %+v) = %v, want %v", tt.subject, tt.action, tt.resource, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	authorizer := NewAuthorizer()
+	resource := Resource{OwnerId: "owner"}
+
+	err := authorizer.Authorize(NewSubject("owner", nil), ActionUpdatePost, resource)
+	if err != nil {
+		t.Errorf("Authorize() for owner returned %v, want nil", err)
+	}
+
+	err = authorizer.Authorize(NewSubject("other", nil), ActionUpdatePost, resource)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Authorize() for other user returned %v, want PermissionDenied", err)
+	}
+}
@@ -0,0 +1,29 @@
+package authorization
+
+func defaultPolicies() map[Action][]Policy {
+	return map[Action][]Policy{
+		ActionUpdatePost:      {IsOwner, HasRole(RoleAdmin)},
+		ActionDeletePost:      {IsOwner, HasRole(RoleModerator), HasRole(RoleAdmin)},
+		ActionPinPost:         {IsOwner},
+		ActionRestorePost:     {IsOwner, HasRole(RoleAdmin)},
+		ActionUpdateDraft:     {IsOwner},
+		ActionDeleteComment:   {IsOwner, IsPostAuthor, HasRole(RoleModerator), HasRole(RoleAdmin)},
+		ActionModerateContent: {HasRole(RoleModerator), HasRole(RoleAdmin)},
+	}
+}
+
+func IsOwner(subject Subject, resource Resource) bool {
+	return subject.UserId != "" && subject.UserId == resource.OwnerId
+}
+
+// IsPostAuthor grants actions on comments and replies to the author of the
+// post they were written on.
+func IsPostAuthor(subject Subject, resource Resource) bool {
+	return subject.UserId != "" && subject.UserId == resource.PostAuthorId
+}
+
+func HasRole(role Role) Policy {
+	return func(subject Subject, resource Resource) bool {
+		return subject.HasRole(role)
+	}
+}
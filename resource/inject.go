@@ -3,17 +3,25 @@ package resource
 import (
 	"github.com/relaunch-cot/service-post/handler"
 	"github.com/relaunch-cot/service-post/repositories"
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"github.com/relaunch-cot/service-post/resource/ratelimit"
 	"github.com/relaunch-cot/service-post/server"
 )
 
 var Repositories repositories.Repositories
 var Handler handler.Handlers
 var Server server.Servers
+var TokenVerifier *authentication.Verifier
+var RateLimiter *ratelimit.Limiter
 
 func Inject() {
 	mysqlClient := OpenMysqlConn()
+	moderator := LoadModerator()
+	TokenVerifier = LoadTokenVerifier()
+	RateLimiter = NewRateLimiter()
+	timelines := NewTimelineWorkerPool()
 
 	Repositories.Inject(mysqlClient)
-	Handler.Inject(&Repositories)
+	Handler.Inject(&Repositories, moderator, timelines)
 	Server.Inject(&Handler)
 }
@@ -0,0 +1,17 @@
+package resource
+
+import (
+	"log"
+
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/resource/moderation"
+)
+
+func LoadModerator() *moderation.Moderator {
+	moderator, err := moderation.NewModerator(config.MODERATION_RULES_FILE)
+	if err != nil {
+		log.Fatal("failed to load moderation rules: ", err)
+	}
+
+	return moderator
+}
@@ -0,0 +1,119 @@
+package moderation
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Action string
+
+const (
+	ActionAllow  Action = "allow"
+	ActionMask   Action = "mask"
+	ActionHold   Action = "hold"
+	ActionReject Action = "reject"
+)
+
+type ContentKind string
+
+const (
+	ContentKindTitle   ContentKind = "title"
+	ContentKindPost    ContentKind = "post"
+	ContentKindComment ContentKind = "comment"
+)
+
+type Result struct {
+	Action Action
+	Text   string
+	Reason string
+}
+
+type Rule interface {
+	Apply(kind ContentKind, text string) Result
+}
+
+type Moderator struct {
+	mu          sync.RWMutex
+	rulesFile   string
+	rules       []Rule
+	customRules []Rule
+}
+
+func NewModerator(rulesFile string) (*Moderator, error) {
+	moderator := &Moderator{
+		rulesFile: rulesFile,
+	}
+
+	err := moderator.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return moderator, nil
+}
+
+func (m *Moderator) Register(rule Rule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.customRules = append(m.customRules, rule)
+}
+
+func (m *Moderator) Reload() error {
+	rulesConfig := defaultRulesConfig()
+	if m.rulesFile != "" {
+		b, err := os.ReadFile(m.rulesFile)
+		if err != nil {
+			return status.Error(codes.Internal, "error reading moderation rules. Details: "+err.Error())
+		}
+
+		err = json.Unmarshal(b, rulesConfig)
+		if err != nil {
+			return status.Error(codes.Internal, "error parsing moderation rules. Details: "+err.Error())
+		}
+	}
+
+	rules, err := rulesConfig.build()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.rules = rules
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *Moderator) Moderate(kind ContentKind, text string) Result {
+	m.mu.RLock()
+	rules := append(append([]Rule{}, m.rules...), m.customRules...)
+	m.mu.RUnlock()
+
+	result := Result{
+		Action: ActionAllow,
+		Text:   text,
+	}
+
+	for _, rule := range rules {
+		ruleResult := rule.Apply(kind, result.Text)
+		switch ruleResult.Action {
+		case ActionReject:
+			return ruleResult
+		case ActionHold:
+			result.Action = ActionHold
+			result.Reason = ruleResult.Reason
+		case ActionMask:
+			result.Text = ruleResult.Text
+			if result.Action == ActionAllow {
+				result.Action = ActionMask
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,261 @@
+package moderation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// wordBoundary matches where a word may start. RE2's \b only treats ASCII
+// letters as word characters, so it would match inside words such as "ação".
+const wordBoundary = `(?:^|[^\p{L}\p{N}_])`
+
+var linkRegex = regexp.MustCompile(`(?i)` + wordBoundary + `((?:https?://)?(?:[a-z0-9-]+\.)+[a-z]{2,}(?:/[^\s]*)?)`)
+
+type rulesConfig struct {
+	MaxLength   map[ContentKind]int `json:"maxLength"`
+	Words       []listRuleConfig    `json:"words"`
+	Patterns    []listRuleConfig    `json:"patterns"`
+	LinkDomains []listRuleConfig    `json:"linkDomains"`
+}
+
+type listRuleConfig struct {
+	Values []string `json:"values"`
+	Action Action   `json:"action"`
+}
+
+func defaultRulesConfig() *rulesConfig {
+	return &rulesConfig{
+		MaxLength: map[ContentKind]int{
+			ContentKindTitle:   255,
+			ContentKindPost:    10000,
+			ContentKindComment: 2000,
+		},
+	}
+}
+
+func (c *rulesConfig) build() ([]Rule, error) {
+	rules := []Rule{&maxLengthRule{limits: c.MaxLength}}
+
+	for _, words := range c.Words {
+		if len(words.Values) == 0 {
+			continue
+		}
+
+		rule, err := newWordRule(words.Values, words.Action)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	for _, patterns := range c.Patterns {
+		for _, pattern := range patterns.Values {
+			rule, err := newPatternRule(pattern, patterns.Action, "blocked pattern")
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	for _, domains := range c.LinkDomains {
+		err := validateAction(domains.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		normalized := make([]string, 0, len(domains.Values))
+		for _, domain := range domains.Values {
+			normalized = append(normalized, strings.ToLower(strings.TrimPrefix(domain, ".")))
+		}
+		rules = append(rules, &linkDomainRule{domains: normalized, action: domains.Action})
+	}
+
+	return rules, nil
+}
+
+func validateAction(action Action) error {
+	switch action {
+	case ActionMask, ActionHold, ActionReject:
+		return nil
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("invalid moderation action %q", action))
+	}
+}
+
+type maxLengthRule struct {
+	limits map[ContentKind]int
+}
+
+func (r *maxLengthRule) Apply(kind ContentKind, text string) Result {
+	limit, ok := r.limits[kind]
+	if ok && limit > 0 && utf8.RuneCountInString(text) > limit {
+		return Result{Action: ActionReject, Text: text, Reason: fmt.Sprintf("%s exceeds the maximum length of %d characters", kind, limit)}
+	}
+
+	return Result{Action: ActionAllow, Text: text}
+}
+
+type wordRule struct {
+	regex  *regexp.Regexp
+	action Action
+}
+
+func newWordRule(words []string, action Action) (*wordRule, error) {
+	err := validateAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Longer words go first so "scammer" is not cut short by "scam".
+	sorted := append([]string{}, words...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return utf8.RuneCountInString(sorted[i]) > utf8.RuneCountInString(sorted[j])
+	})
+
+	quoted := make([]string, 0, len(sorted))
+	for _, word := range sorted {
+		quoted = append(quoted, regexp.QuoteMeta(word))
+	}
+
+	regex, err := regexp.Compile(`(?i)` + wordBoundary + `(` + strings.Join(quoted, "|") + `)`)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid moderation words. Details: "+err.Error())
+	}
+
+	return &wordRule{regex: regex, action: action}, nil
+}
+
+func (r *wordRule) Apply(kind ContentKind, text string) Result {
+	matched := false
+	masked := replaceMatches(r.regex, text, func(word string, end int) string {
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if end < len(text) && isWordRune(next) {
+			return word
+		}
+
+		matched = true
+		return mask(word)
+	})
+
+	if !matched {
+		return Result{Action: ActionAllow, Text: text}
+	}
+
+	if r.action == ActionMask {
+		return Result{Action: ActionMask, Text: masked, Reason: "blocked word"}
+	}
+
+	return Result{Action: r.action, Text: text, Reason: "content contains a blocked word"}
+}
+
+type patternRule struct {
+	regex  *regexp.Regexp
+	action Action
+	reason string
+}
+
+func newPatternRule(pattern string, action Action, reason string) (*patternRule, error) {
+	err := validateAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid moderation pattern. Details: "+err.Error())
+	}
+
+	return &patternRule{regex: regex, action: action, reason: reason}, nil
+}
+
+func (r *patternRule) Apply(kind ContentKind, text string) Result {
+	if !r.regex.MatchString(text) {
+		return Result{Action: ActionAllow, Text: text}
+	}
+
+	if r.action == ActionMask {
+		return Result{Action: ActionMask, Text: r.regex.ReplaceAllStringFunc(text, mask), Reason: r.reason}
+	}
+
+	return Result{Action: r.action, Text: text, Reason: "content contains a " + r.reason}
+}
+
+type linkDomainRule struct {
+	domains []string
+	action  Action
+}
+
+func (r *linkDomainRule) Apply(kind ContentKind, text string) Result {
+	matched := false
+	masked := replaceMatches(linkRegex, text, func(link string, end int) string {
+		if !r.isBlocked(link) {
+			return link
+		}
+
+		matched = true
+		return mask(link)
+	})
+
+	if !matched {
+		return Result{Action: ActionAllow, Text: text}
+	}
+
+	if r.action == ActionMask {
+		return Result{Action: ActionMask, Text: masked, Reason: "blocked link domain"}
+	}
+
+	return Result{Action: r.action, Text: text, Reason: "content contains a link to a blocked domain"}
+}
+
+func (r *linkDomainRule) isBlocked(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, domain := range r.domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replaceMatches returns text with the first group of every regex match
+// replaced by replace, which also gets the byte offset where the group ends.
+func replaceMatches(regex *regexp.Regexp, text string, replace func(match string, end int) string) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range regex.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[2], match[3]
+		builder.WriteString(text[last:start])
+		builder.WriteString(replace(text[start:end], end))
+		last = end
+	}
+	builder.WriteString(text[last:])
+
+	return builder.String()
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+func mask(text string) string {
+	return strings.Repeat("*", utf8.RuneCountInString(text))
+}
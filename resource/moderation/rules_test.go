@@ -0,0 +1,129 @@
+package moderation
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestModerator(t *testing.T, config *rulesConfig) *Moderator {
+	t.Helper()
+
+	rules, err := config.build()
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	return &Moderator{rules: rules}
+}
+
+func TestWordRule(t *testing.T) {
+	moderator := newTestModerator(t, &rulesConfig{
+		Words: []listRuleConfig{
+			{Values: []string{"scam", "scammer", "ação"}, Action: ActionMask},
+			{Values: []string{"spam"}, Action: ActionHold},
+		},
+	})
+
+	tests := []struct {
+		name       string
+		text       string
+		wantAction Action
+		wantText   string
+	}{
+		{"clean", "a perfectly fine post", ActionAllow, "a perfectly fine post"},
+		{"whole word", "this is a scam", ActionMask, "this is a ****"},
+		{"case-insensitive", "SCAM alert", ActionMask, "**** alert"},
+		{"longest word wins", "a scammer here", ActionMask, "a ******* here"},
+		{"repeated words", "scam scam", ActionMask, "**** ****"},
+		{"punctuation around", "(scam), scam!", ActionMask, "(****), ****!"},
+		{"inside ascii word", "scamp and ascam", ActionAllow, "scamp and ascam"},
+		{"after accented letter", "éscam", ActionAllow, "éscam"},
+		{"before accented letter", "scamé", ActionAllow, "scamé"},
+		{"accented word", "uma ação ruim", ActionMask, "uma **** ruim"},
+		{"accented word inside word", "reação", ActionAllow, "reação"},
+		{"after underscore", "my_scam", ActionAllow, "my_scam"},
+		{"hold", "buy spam now", ActionHold, "buy spam now"},
+		{"hold inside word", "spammy", ActionAllow, "spammy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := moderator.Moderate(ContentKindPost, tt.text)
+			if result.Action != tt.wantAction || result.Text != tt.wantText {
+				t.Errorf("Moderate(%q) = %q, %q, want %q, %q", tt.text, result.Action, result.Text, tt.wantAction, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestLinkDomainRule(t *testing.T) {
+	moderator := newTestModerator(t, &rulesConfig{
+		LinkDomains: []listRuleConfig{
+			{Values: []string{".evil.com"}, Action: ActionMask},
+		},
+	})
+
+	tests := []struct {
+		name       string
+		text       string
+		wantAction Action
+		wantText   string
+	}{
+		{"other domain", "see example.com", ActionAllow, "see example.com"},
+		{"bare domain", "see evil.com", ActionMask, "see ********"},
+		{"subdomain with scheme", "https://www.evil.com/path", ActionMask, "*************************"},
+		{"lookalike domain", "notevil.com", ActionAllow, "notevil.com"},
+		{"after accented letter", "véevil.com", ActionAllow, "véevil.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := moderator.Moderate(ContentKindPost, tt.text)
+			if result.Action != tt.wantAction || result.Text != tt.wantText {
+				t.Errorf("Moderate(%q) = %q, %q, want %q, %q", tt.text, result.Action, result.Text, tt.wantAction, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestMaxLengthRule(t *testing.T) {
+	moderator := newTestModerator(t, defaultRulesConfig())
+
+	tests := []struct {
+		name       string
+		kind       ContentKind
+		text       string
+		wantAction Action
+	}{
+		{"title at limit", ContentKindTitle, strings.Repeat("á", 255), ActionAllow},
+		{"title over limit", ContentKindTitle, strings.Repeat("á", 256), ActionReject},
+		{"comment over limit", ContentKindComment, strings.Repeat("a", 2001), ActionReject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := moderator.Moderate(tt.kind, tt.text); result.Action != tt.wantAction {
+				t.Errorf("Moderate() action = %q, want %q", result.Action, tt.wantAction)
+			}
+		})
+	}
+}
+
+func TestRulesConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *rulesConfig
+	}{
+		{"word action", &rulesConfig{Words: []listRuleConfig{{Values: []string{"scam"}, Action: "ban"}}}},
+		{"pattern", &rulesConfig{Patterns: []listRuleConfig{{Values: []string{"("}, Action: ActionHold}}}},
+		{"link action", &rulesConfig{LinkDomains: []listRuleConfig{{Values: []string{"evil.com"}, Action: ActionAllow}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.config.build(); err == nil {
+				t.Error("build() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultLimit int64 = 20
+	MaxLimit     int64 = 100
+
+	cursorSeparator = "|"
+)
+
+type Cursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+func EncodeCursor(createdAt, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt + cursorSeparator + id))
+}
+
+func DecodeCursor(cursor string) (*Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	parts := strings.SplitN(string(b), cursorSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	return &Cursor{
+		CreatedAt: createdAt,
+		Id:        parts[1],
+	}, nil
+}
+
+func EncodeOffsetCursor(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+func DecodeOffsetCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	offset, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	return offset, nil
+}
+
+func NormalizeLimit(limit int64) int64 {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+
+	return limit
+}
@@ -0,0 +1,136 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdAt string
+		id        string
+	}{
+		{"seconds", "2026-01-02T03:04:05Z", "post-1"},
+		{"nanoseconds", "2026-01-02T03:04:05.123456789Z", "post-2"},
+		{"offset", "2026-01-02T03:04:05-03:00", "post-3"},
+		{"separator in id", "2026-01-02T03:04:05Z", "post|4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(EncodeCursor(tt.createdAt, tt.id))
+			if err != nil {
+				t.Fatalf("DecodeCursor() error = %v", err)
+			}
+
+			wantCreatedAt, _ := time.Parse(time.RFC3339Nano, tt.createdAt)
+			if !cursor.CreatedAt.Equal(wantCreatedAt) {
+				t.Errorf("CreatedAt = %v, want %v", cursor.CreatedAt, wantCreatedAt)
+			}
+			if cursor.Id != tt.id {
+				t.Errorf("Id = %q, want %q", cursor.Id, tt.id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorEmpty(t *testing.T) {
+	cursor, err := DecodeCursor("")
+	if cursor != nil || err != nil {
+		t.Errorf("DecodeCursor(\"\") = %v, %v, want nil, nil", cursor, err)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "not a cursor!"},
+		{"no separator", encode("2026-01-02T03:04:05Z")},
+		{"empty id", encode("2026-01-02T03:04:05Z|")},
+		{"bad time", encode("yesterday|post-1")},
+		{"offset cursor", EncodeOffsetCursor(20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCursor(tt.cursor)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("DecodeCursor() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestOffsetCursorRoundTrip(t *testing.T) {
+	for _, offset := range []int64{0, 1, 20, 1 << 40} {
+		got, err := DecodeOffsetCursor(EncodeOffsetCursor(offset))
+		if err != nil {
+			t.Fatalf("DecodeOffsetCursor(%d) error = %v", offset, err)
+		}
+		if got != offset {
+			t.Errorf("DecodeOffsetCursor() = %d, want %d", got, offset)
+		}
+	}
+}
+
+func TestDecodeOffsetCursor(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name     string
+		cursor   string
+		want     int64
+		wantCode codes.Code
+	}{
+		{"empty", "", 0, codes.OK},
+		{"valid", encode("40"), 40, codes.OK},
+		{"not base64", "not a cursor!", 0, codes.InvalidArgument},
+		{"not a number", encode("forty"), 0, codes.InvalidArgument},
+		{"negative", encode("-1"), 0, codes.InvalidArgument},
+		{"time cursor", EncodeCursor("2026-01-02T03:04:05Z", "post-1"), 0, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeOffsetCursor(tt.cursor)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("DecodeOffsetCursor() error = %v, want code %v", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("DecodeOffsetCursor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeLimit(t *testing.T) {
+	tests := []struct {
+		limit int64
+		want  int64
+	}{
+		{-5, DefaultLimit},
+		{0, DefaultLimit},
+		{1, 1},
+		{MaxLimit, MaxLimit},
+		{MaxLimit + 1, MaxLimit},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeLimit(tt.limit); got != tt.want {
+			t.Errorf("NormalizeLimit(%d) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+const maxHashtagLength = 64
+
+var hashtagRegex = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&#])#([\p{L}\p{N}_]+)`)
+
+func ExtractHashtags(text string) []string {
+	matches := hashtagRegex.FindAllStringSubmatch(text, -1)
+
+	seen := make(map[string]bool)
+	hashtags := make([]string, 0)
+	for _, match := range matches {
+		hashtag := strings.ToLower(match[1])
+		if len([]rune(hashtag)) > maxHashtagLength || seen[hashtag] {
+			continue
+		}
+
+		seen[hashtag] = true
+		hashtags = append(hashtags, hashtag)
+	}
+
+	return hashtags
+}
+
+func NormalizeHashtag(hashtag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(hashtag), "#"))
+}
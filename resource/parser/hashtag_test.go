@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	longTag := strings.Repeat("a", maxHashtagLength)
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"none", "no tags here", []string{}},
+		{"single", "#golang", []string{"golang"}},
+		{"several", "learning #Go and #gRPC today", []string{"go", "grpc"}},
+		{"deduplicated case-insensitively", "#Go #go #GO", []string{"go"}},
+		{"punctuation ends tag", "(#go), #rust.", []string{"go", "rust"}},
+		{"unicode letters", "#café #日本語 #ação", []string{"café", "日本語", "ação"}},
+		{"digits and underscores", "#web_3 #2026", []string{"web_3", "2026"}},
+		{"inside word", "email#tag", []string{}},
+		{"html entity", "&#39;quoted&#39;", []string{}},
+		{"double hash", "##go", []string{}},
+		{"bare hash", "# go", []string{}},
+		{"max length", "#" + longTag, []string{longTag}},
+		{"too long", "#" + longTag + "a", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractHashtags(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractHashtags(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeHashtag(t *testing.T) {
+	tests := []struct {
+		hashtag string
+		want    string
+	}{
+		{"go", "go"},
+		{"#Go", "go"},
+		{"  #GoLang ", "golang"},
+		{"#Café", "café"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeHashtag(tt.hashtag); got != tt.want {
+			t.Errorf("NormalizeHashtag(%q) = %q, want %q", tt.hashtag, got, tt.want)
+		}
+	}
+}
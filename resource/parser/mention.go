@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var mentionRegex = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@.])@([\p{L}\p{N}_.]+)`)
+
+func ExtractMentions(text string) []string {
+	matches := mentionRegex.FindAllStringSubmatch(text, -1)
+
+	seen := make(map[string]bool)
+	mentions := make([]string, 0)
+	for _, match := range matches {
+		mention := strings.TrimRight(match[1], ".")
+		key := strings.ToLower(mention)
+		if mention == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
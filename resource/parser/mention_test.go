@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"none", "no mentions here", []string{}},
+		{"single", "@alice", []string{"alice"}},
+		{"several", "thanks @alice and @bob!", []string{"alice", "bob"}},
+		{"deduplicated case-insensitively", "@Alice @alice", []string{"Alice"}},
+		{"dots inside handle", "@john.doe", []string{"john.doe"}},
+		{"trailing dot trimmed", "ask @alice.", []string{"alice"}},
+		{"unicode letters", "@joão @ünal", []string{"joão", "ünal"}},
+		{"digits and underscores", "@dev_42", []string{"dev_42"}},
+		{"email address", "mail me at alice@example.com", []string{}},
+		{"double at", "@@alice", []string{}},
+		{"bare at", "@ alice", []string{}},
+		{"only dots", "@...", []string{}},
+		{"punctuation before", "(@alice)", []string{"alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractMentions(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractMentions(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
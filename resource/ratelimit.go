@@ -0,0 +1,24 @@
+package resource
+
+import (
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/resource/ratelimit"
+)
+
+const (
+	defaultRateLimitPerMinute     = 120
+	defaultCreatePostRateLimit    = 10
+	defaultCreateCommentRateLimit = 30
+	defaultUpdateLikesRateLimit   = 60
+)
+
+func NewRateLimiter() *ratelimit.Limiter {
+	return ratelimit.NewLimiter(
+		ratelimit.Limit{PerMinute: config.ParseInt(config.RATE_LIMIT_DEFAULT_PER_MINUTE, defaultRateLimitPerMinute)},
+		map[string]ratelimit.Limit{
+			"CreatePost":                   {PerMinute: config.ParseInt(config.RATE_LIMIT_CREATE_POST_PER_MINUTE, defaultCreatePostRateLimit)},
+			"CreateCommentOrReply":         {PerMinute: config.ParseInt(config.RATE_LIMIT_CREATE_COMMENT_PER_MINUTE, defaultCreateCommentRateLimit)},
+			"UpdateLikesFromPostOrComment": {PerMinute: config.ParseInt(config.RATE_LIMIT_UPDATE_LIKES_PER_MINUTE, defaultUpdateLikesRateLimit)},
+		},
+	)
+}
@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RetryAfterMetadataKey = "retry-after"
+	pruneInterval         = 10 * time.Minute
+)
+
+// Limit allows PerMinute calls per minute with bursts of up to PerMinute
+// calls.
+type Limit struct {
+	PerMinute int64
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// Limiter keeps one in-memory token bucket per user and method.
+type Limiter struct {
+	mu           sync.Mutex
+	defaultLimit Limit
+	limits       map[string]Limit
+	buckets      map[string]*bucket
+	prunedAt     time.Time
+}
+
+func NewLimiter(defaultLimit Limit, limits map[string]Limit) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		limits:       limits,
+		buckets:      make(map[string]*bucket),
+		prunedAt:     time.Now(),
+	}
+}
+
+// Allow takes a token from the bucket of key for method. When the bucket is
+// empty it returns false and how long until the next token is available.
+func (l *Limiter) Allow(key, method string) (bool, time.Duration) {
+	limit, ok := l.limits[method]
+	if !ok {
+		limit = l.defaultLimit
+	}
+
+	if limit.PerMinute <= 0 {
+		return true, 0
+	}
+
+	capacity := float64(limit.PerMinute)
+	refillPerSecond := capacity / 60
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.pruneIdleBuckets(now)
+
+	bucketKey := key + "|" + method
+	b, ok := l.buckets[bucketKey]
+	if !ok {
+		b = &bucket{tokens: capacity, updatedAt: now}
+		l.buckets[bucketKey] = b
+	}
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updatedAt).Seconds()*refillPerSecond)
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / refillPerSecond * float64(time.Second))
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// pruneIdleBuckets drops buckets untouched for a whole prune interval. Every
+// limit refills within a minute, so they would be full again anyway.
+func (l *Limiter) pruneIdleBuckets(now time.Time) {
+	if now.Sub(l.prunedAt) < pruneInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updatedAt) >= pruneInterval {
+			delete(l.buckets, key)
+		}
+	}
+	l.prunedAt = now
+}
+
+// Exhausted builds a ResourceExhausted error and sends the wait, rounded up
+// to whole seconds, in the retry-after trailer.
+func Exhausted(ctx context.Context, retryAfter time.Duration, message string) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterMetadataKey, strconv.FormatInt(seconds, 10)))
+
+	return status.Error(codes.ResourceExhausted, message)
+}
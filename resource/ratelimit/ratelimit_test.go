@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	tests := []struct {
+		name        string
+		limits      map[string]Limit
+		method      string
+		wantAllowed int
+	}{
+		{"default limit", nil, "GetPost", 3},
+		{"method limit", map[string]Limit{"CreatePost": {PerMinute: 1}}, "CreatePost", 1},
+		{"other method uses default", map[string]Limit{"CreatePost": {PerMinute: 1}}, "GetPost", 3},
+		{"zero limit is unlimited", map[string]Limit{"GetPost": {PerMinute: 0}}, "GetPost", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewLimiter(Limit{PerMinute: 3}, tt.limits)
+
+			allowed := 0
+			for i := 0; i < 10; i++ {
+				if ok, _ := limiter.Allow("user-1", tt.method); ok {
+					allowed++
+				}
+			}
+
+			if allowed != tt.wantAllowed {
+				t.Errorf("allowed %d of 10 calls, want %d", allowed, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestLimiterRetryAfter(t *testing.T) {
+	limiter := NewLimiter(Limit{PerMinute: 60}, map[string]Limit{"CreatePost": {PerMinute: 2}})
+
+	for i := 0; i < 2; i++ {
+		if ok, retryAfter := limiter.Allow("user-1", "CreatePost"); !ok || retryAfter != 0 {
+			t.Fatalf("call %d = %v, %v, want true, 0", i, ok, retryAfter)
+		}
+	}
+
+	ok, retryAfter := limiter.Allow("user-1", "CreatePost")
+	if ok {
+		t.Fatal("call over the limit was allowed")
+	}
+
+	// Two calls per minute refill one token every 30 seconds.
+	if retryAfter <= 29*time.Second || retryAfter > 30*time.Second {
+		t.Errorf("retryAfter = %v, want just under 30s", retryAfter)
+	}
+}
+
+func TestLimiterBucketsAreIndependent(t *testing.T) {
+	limiter := NewLimiter(Limit{PerMinute: 1}, nil)
+
+	tests := []struct {
+		key    string
+		method string
+		want   bool
+	}{
+		{"user-1", "CreatePost", true},
+		{"user-1", "CreatePost", false},
+		{"user-2", "CreatePost", true},
+		{"user-1", "GetPost", true},
+		{"user-2", "GetPost", true},
+		{"user-2", "GetPost", false},
+	}
+
+	for _, tt := range tests {
+		if ok, _ := limiter.Allow(tt.key, tt.method); ok != tt.want {
+			t.Errorf("Allow(%q, %q) = %v, want %v", tt.key, tt.method, ok, tt.want)
+		}
+	}
+}
+
+func TestLimiterPrunesIdleBuckets(t *testing.T) {
+	limiter := NewLimiter(Limit{PerMinute: 1}, nil)
+	limiter.Allow("user-1", "GetPost")
+	limiter.Allow("user-2", "GetPost")
+
+	idleSince := time.Now().Add(-pruneInterval)
+	limiter.buckets["user-1|GetPost"].updatedAt = idleSince
+	limiter.prunedAt = idleSince
+
+	limiter.Allow("user-3", "GetPost")
+
+	if _, ok := limiter.buckets["user-1|GetPost"]; ok {
+		t.Error("idle bucket was not pruned")
+	}
+	if _, ok := limiter.buckets["user-2|GetPost"]; !ok {
+		t.Error("active bucket was pruned")
+	}
+}
+
+type trailerStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerStream) Method() string {
+	return "/post.PostService/CreatePost"
+}
+
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestExhausted(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		want       string
+	}{
+		{"whole seconds", 3 * time.Second, "3"},
+		{"rounded up", 2500 * time.Millisecond, "3"},
+		{"at least one second", 10 * time.Millisecond, "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &trailerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+			err := Exhausted(ctx, tt.retryAfter, "too many requests")
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("Exhausted() error = %v, want ResourceExhausted", err)
+			}
+
+			got := stream.trailer.Get(RetryAfterMetadataKey)
+			if len(got) != 1 || got[0] != tt.want {
+				t.Errorf("%s trailer = %v, want [%s]", RetryAfterMetadataKey, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,11 +5,12 @@ import (
 
 	libModels "github.com/relaunch-cot/lib-relaunch-cot/models"
 	pbBaseModels "github.com/relaunch-cot/lib-relaunch-cot/proto/base_models"
+	"github.com/relaunch-cot/service-post/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func GetPostToBaseModels(post *libModels.Post) (*pbBaseModels.Post, error) {
+func GetPostToBaseModels(post *models.Post) (*pbBaseModels.Post, error) {
 	b, err := json.Marshal(post)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "error marshalling post. Details: "+err.Error())
@@ -24,7 +25,7 @@ func GetPostToBaseModels(post *libModels.Post) (*pbBaseModels.Post, error) {
 	return &pbPost, nil
 }
 
-func GetAllPostsToBaseModels(posts []*libModels.Post) ([]*pbBaseModels.Post, error) {
+func GetAllPostsToBaseModels(posts []*models.Post) ([]*pbBaseModels.Post, error) {
 	var pbPosts []*pbBaseModels.Post
 	for _, post := range posts {
 		pbPost, err := GetPostToBaseModels(post)
@@ -36,7 +37,7 @@ func GetAllPostsToBaseModels(posts []*libModels.Post) ([]*pbBaseModels.Post, err
 	return pbPosts, nil
 }
 
-func GetAllPostsFromUserToBaseModels(posts []*libModels.Post) ([]*pbBaseModels.Post, error) {
+func GetAllPostsFromUserToBaseModels(posts []*models.Post) ([]*pbBaseModels.Post, error) {
 	var pbPosts []*pbBaseModels.Post
 	for _, post := range posts {
 		pbPost, err := GetPostToBaseModels(post)
@@ -63,7 +64,7 @@ func GetAllLikesFromPostOrCommentToBaseModels(postLikes *libModels.PostLikes) (*
 	return pbPostLikes, nil
 }
 
-func GetAllCommentsFromPostToBaseModels(postComments *libModels.PostComments) (*pbBaseModels.PostComments, error) {
+func GetAllCommentsFromPostToBaseModels(postComments *models.PostComments) (*pbBaseModels.PostComments, error) {
 	var pbPostComments *pbBaseModels.PostComments
 	b, err := json.Marshal(postComments)
 	if err != nil {
@@ -77,3 +78,33 @@ func GetAllCommentsFromPostToBaseModels(postComments *libModels.PostComments) (*
 
 	return pbPostComments, nil
 }
+
+func GetAllTagCountsToBaseModels(tags []*models.TagCount) ([]*pbBaseModels.TagCount, error) {
+	var pbTags []*pbBaseModels.TagCount
+	b, err := json.Marshal(tags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error marshalling tags. Details: "+err.Error())
+	}
+
+	err = json.Unmarshal(b, &pbTags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error unmarshalling tags. Details: "+err.Error())
+	}
+
+	return pbTags, nil
+}
+
+func GetModerationQueueToBaseModels(items []*models.ModerationQueueItem) ([]*pbBaseModels.ModerationQueueItem, error) {
+	var pbItems []*pbBaseModels.ModerationQueueItem
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error marshalling moderation queue. Details: "+err.Error())
+	}
+
+	err = json.Unmarshal(b, &pbItems)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error unmarshalling moderation queue. Details: "+err.Error())
+	}
+
+	return pbItems, nil
+}
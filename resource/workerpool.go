@@ -0,0 +1,18 @@
+package resource
+
+import (
+	"github.com/relaunch-cot/service-post/config"
+	"github.com/relaunch-cot/service-post/resource/workerpool"
+)
+
+const (
+	defaultTimelineWorkers   = 4
+	defaultTimelineQueueSize = 1000
+)
+
+func NewTimelineWorkerPool() *workerpool.Pool {
+	return workerpool.NewPool(
+		int(config.ParseInt(config.TIMELINE_WORKERS, defaultTimelineWorkers)),
+		int(config.ParseInt(config.TIMELINE_QUEUE_SIZE, defaultTimelineQueueSize)),
+	)
+}
@@ -0,0 +1,64 @@
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const taskTimeout = time.Minute
+
+// Task is background work that runs after the request that submitted it has
+// returned, so it gets its own context.
+type Task struct {
+	Name string
+	Run  func(ctx *context.Context) error
+}
+
+// Pool runs tasks on a fixed number of goroutines fed by a bounded queue.
+type Pool struct {
+	tasks chan Task
+}
+
+func NewPool(workers, queueSize int) *Pool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	p := &Pool{
+		tasks: make(chan Task, queueSize),
+	}
+
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+
+	return p
+}
+
+// Submit queues task, waiting for room in the queue until ctx is done. It
+// returns an error when the task could not be queued, so callers can handle
+// the work some other way.
+func (p *Pool) Submit(ctx context.Context, task Task) error {
+	select {
+	case p.tasks <- task:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("task %q not queued: %w", task.Name, ctx.Err())
+	}
+}
+
+func (p *Pool) work() {
+	for task := range p.tasks {
+		ctx, cancel := context.WithTimeout(context.Background(), taskTimeout)
+		err := task.Run(&ctx)
+		cancel()
+		if err != nil {
+			log.Printf("task %q failed: %v\n", task.Name, err)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPoolRunsSubmittedTasks(t *testing.T) {
+	tests := []struct {
+		name      string
+		workers   int
+		queueSize int
+		tasks     int
+	}{
+		{"one worker", 1, 1, 5},
+		{"several workers", 4, 8, 20},
+		{"unbuffered queue", 2, 0, 5},
+		{"zero workers uses one", 0, 1, 3},
+		{"negative queue is unbuffered", 1, -1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := NewPool(tt.workers, tt.queueSize)
+
+			var wg sync.WaitGroup
+			wg.Add(tt.tasks)
+			for i := 0; i < tt.tasks; i++ {
+				err := pool.Submit(context.Background(), Task{
+					Name: "count",
+					Run: func(ctx *context.Context) error {
+						wg.Done()
+						return nil
+					},
+				})
+				if err != nil {
+					t.Fatalf("Submit() error = %v", err)
+				}
+			}
+
+			waitOrFail(t, &wg)
+		})
+	}
+}
+
+func TestPoolSubmitWhenQueueIsFull(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr error
+	}{
+		{
+			name: "canceled context",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "expired deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := NewPool(1, 1)
+
+			release := make(chan struct{})
+			defer close(release)
+			started := make(chan struct{}, 1)
+			blocking := Task{
+				Name: "block",
+				Run: func(ctx *context.Context) error {
+					select {
+					case started <- struct{}{}:
+					default:
+					}
+					<-release
+					return nil
+				},
+			}
+
+			// The first task occupies the only worker and the second fills
+			// the queue, so the next Submit has nowhere to go.
+			if err := pool.Submit(context.Background(), blocking); err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+			<-started
+			if err := pool.Submit(context.Background(), blocking); err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			err := pool.Submit(ctx, Task{Name: "rejected", Run: func(ctx *context.Context) error { return nil }})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Submit() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPoolTaskContextHasDeadline(t *testing.T) {
+	pool := NewPool(1, 1)
+
+	deadlines := make(chan time.Duration, 1)
+	err := pool.Submit(context.Background(), Task{
+		Name: "deadline",
+		Run: func(ctx *context.Context) error {
+			deadline, ok := (*ctx).Deadline()
+			if !ok {
+				deadlines <- 0
+				return nil
+			}
+			deadlines <- time.Until(deadline)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
+
+	select {
+	case remaining := <-deadlines:
+		if remaining <= 0 || remaining > taskTimeout {
+			t.Errorf("task deadline in %v, want within %v", remaining, taskTimeout)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("task did not run")
+	}
+}
+
+func TestPoolKeepsWorkingAfterFailedTask(t *testing.T) {
+	pool := NewPool(1, 2)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	tasks := []Task{
+		{Name: "fail", Run: func(ctx *context.Context) error { wg.Done(); return errors.New("boom") }},
+		{Name: "succeed", Run: func(ctx *context.Context) error { wg.Done(); return nil }},
+	}
+	for _, task := range tasks {
+		if err := pool.Submit(context.Background(), task); err != nil {
+			t.Fatalf("Submit(%q) error = %v", task.Name, err)
+		}
+	}
+
+	waitOrFail(t, &wg)
+}
+
+func waitOrFail(t *testing.T, wg *sync.WaitGroup) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("tasks did not finish")
+	}
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationMetadataKey = "authorization"
+
+// AuthenticationInterceptor validates the bearer token sent in the
+// authorization metadata and stores its subject in the context. Requests
+// without a token continue anonymously; handlers decide what they allow.
+func AuthenticationInterceptor(verifier *authentication.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authorization := getMetadataValue(ctx, authorizationMetadataKey)
+		if authorization == "" {
+			return handler(ctx, req)
+		}
+
+		token, found := strings.CutPrefix(authorization, "Bearer ")
+		if !found {
+			return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+		}
+
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(authentication.ContextWithUserId(ctx, claims.Subject), req)
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	const secret = "test-secret"
+
+	verifier, err := authentication.NewVerifier(secret, nil, "", "")
+	if err != nil {
+		t.Fatalf("NewVerifier(): %v", err)
+	}
+
+	validToken := signTestToken(t, secret, map[string]interface{}{
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	expiredToken := signTestToken(t, secret, map[string]interface{}{
+		"sub": "user-1",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	tests := []struct {
+		name          string
+		authorization []string
+		wantCode      codes.Code
+		wantUserId    string
+	}{
+		{"no metadata", nil, codes.OK, ""},
+		{"empty authorization", []string{""}, codes.OK, ""},
+		{"valid bearer token", []string{"Bearer " + validToken}, codes.OK, "user-1"},
+		{"missing bearer prefix", []string{validToken}, codes.Unauthenticated, ""},
+		{"other scheme", []string{"Basic dXNlcjpwYXNz"}, codes.Unauthenticated, ""},
+		{"malformed token", []string{"Bearer not-a-token"}, codes.Unauthenticated, ""},
+		{"bad signature", []string{"Bearer " + signTestToken(t, "other-secret", map[string]interface{}{"sub": "user-1", "exp": time.Now().Add(time.Hour).Unix()})}, codes.Unauthenticated, ""},
+		{"expired token", []string{"Bearer " + expiredToken}, codes.Unauthenticated, ""},
+	}
+
+	interceptor := AuthenticationInterceptor(verifier)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationMetadataKey, tt.authorization[0]))
+			}
+
+			called := false
+			var gotUserId string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				gotUserId = authentication.UserIdFromContext(ctx)
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{FullMethod: "/post.PostService/GetPost"}, handler)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("interceptor error = %v, want code %v", err, tt.wantCode)
+			}
+
+			if tt.wantCode != codes.OK {
+				if called {
+					t.Error("handler was called for a rejected request")
+				}
+				return
+			}
+
+			if !called {
+				t.Fatal("handler was not called")
+			}
+			if gotUserId != tt.wantUserId {
+				t.Errorf("handler saw user %q, want %q", gotUserId, tt.wantUserId)
+			}
+		})
+	}
+}
+
+func signTestToken(t *testing.T, secret string, claims map[string]interface{}) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		t.Fatalf("encoding token header: %v", err)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("encoding token claims: %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	attachmentsMetadataKey    = "attachments-bin"
+	authorIdsMetadataKey      = "author-ids"
+	clearPublishAtMetadataKey = "clear-publish-at"
+	createdFromMetadataKey    = "created-from"
+	createdToMetadataKey      = "created-to"
+	cursorMetadataKey         = "cursor"
+	feedMetadataKey           = "feed"
+	fromRevisionMetadataKey   = "from-revision"
+	hasImageMetadataKey       = "has-image"
+	likeActionMetadataKey     = "like-action"
+	limitMetadataKey          = "limit"
+	mentionsMetadataKey       = "mentions-bin"
+	postActionMetadataKey     = "post-action"
+	postStatusMetadataKey     = "post-status"
+	postTypesMetadataKey      = "post-types"
+	postViewMetadataKey       = "post-view"
+	publishAtMetadataKey      = "publish-at"
+	quotedPostMetadataKey     = "quoted-post-id"
+	reactionMetadataKey       = "reaction"
+	reactionCountsMetadataKey = "reaction-counts"
+	revisionDiffMetadataKey   = "revision-diff-bin"
+	revisionMetadataKey       = "revision"
+	revisionsMetadataKey      = "revisions-bin"
+	sortMetadataKey           = "sort"
+	toRevisionMetadataKey     = "to-revision"
+	updatedFromMetadataKey    = "updated-from"
+	updatedToMetadataKey      = "updated-to"
+	viewerReactionMetadataKey = "viewer-reaction"
+	visibilityMetadataKey     = "visibility"
+)
+
+func getMetadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// getListFromMetadata splits a comma-separated value, dropping empty items.
+func getListFromMetadata(ctx context.Context, key string) []string {
+	var values []string
+	for _, value := range strings.Split(getMetadataValue(ctx, key), ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func getInt64FromMetadata(ctx context.Context, key string) (int64, error) {
+	value, err := strconv.ParseInt(getMetadataValue(ctx, key), 10, 64)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid "+key)
+	}
+
+	return value, nil
+}
+
+// getBoolFromMetadata parses a boolean, returning false when the key is
+// absent.
+func getBoolFromMetadata(ctx context.Context, key string) (bool, error) {
+	rawBool := getMetadataValue(ctx, key)
+	if rawBool == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(rawBool)
+	if err != nil {
+		return false, status.Error(codes.InvalidArgument, "invalid "+key)
+	}
+
+	return value, nil
+}
+
+// getTimeFromMetadata parses an RFC 3339 timestamp, returning nil when the
+// key is absent.
+func getTimeFromMetadata(ctx context.Context, key string) (*time.Time, error) {
+	rawTime := getMetadataValue(ctx, key)
+	if rawTime == "" {
+		return nil, nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, rawTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid "+key)
+	}
+
+	return &parsedTime, nil
+}
+
+func getPaginationFromMetadata(ctx context.Context) (string, int64, error) {
+	cursor := getMetadataValue(ctx, cursorMetadataKey)
+
+	var limit int64
+	rawLimit := getMetadataValue(ctx, limitMetadataKey)
+	if rawLimit != "" {
+		var err error
+		limit, err = strconv.ParseInt(rawLimit, 10, 64)
+		if err != nil {
+			return "", 0, status.Error(codes.InvalidArgument, "invalid limit")
+		}
+	}
+
+	return cursor, limit, nil
+}
+
+// getPostOptionsFromMetadata reads the post options the request messages
+// have no field for. Attachments are a JSON array, in display order. They
+// stay nil when the key is absent, so updates keep the current attachments,
+// while an empty array removes them.
+func getPostOptionsFromMetadata(ctx context.Context) (*models.PostOptions, error) {
+	options := &models.PostOptions{
+		Visibility:   getMetadataValue(ctx, visibilityMetadataKey),
+		QuotedPostId: getMetadataValue(ctx, quotedPostMetadataKey),
+	}
+
+	rawAttachments := getMetadataValue(ctx, attachmentsMetadataKey)
+	if rawAttachments != "" {
+		attachments := make([]models.PostAttachment, 0)
+		err := json.Unmarshal([]byte(rawAttachments), &attachments)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid attachments")
+		}
+
+		options.Attachments = attachments
+	}
+
+	return options, nil
+}
+
+func getListPostsParamsFromMetadata(ctx context.Context, cursor string, limit int64) (*models.ListPostsParams, error) {
+	filters := models.PostFilters{
+		Types:     getListFromMetadata(ctx, postTypesMetadataKey),
+		AuthorIds: getListFromMetadata(ctx, authorIdsMetadataKey),
+	}
+
+	var err error
+	for key, target := range map[string]**time.Time{
+		createdFromMetadataKey: &filters.CreatedFrom,
+		createdToMetadataKey:   &filters.CreatedTo,
+		updatedFromMetadataKey: &filters.UpdatedFrom,
+		updatedToMetadataKey:   &filters.UpdatedTo,
+	} {
+		*target, err = getTimeFromMetadata(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rawHasImage := getMetadataValue(ctx, hasImageMetadataKey)
+	if rawHasImage != "" {
+		hasImage, err := strconv.ParseBool(rawHasImage)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid "+hasImageMetadataKey)
+		}
+
+		filters.HasImage = &hasImage
+	}
+
+	return &models.ListPostsParams{
+		ViewerId: authentication.UserIdFromContext(ctx),
+		Filters:  filters,
+		SortBy:   getMetadataValue(ctx, sortMetadataKey),
+		Cursor:   cursor,
+		Limit:    limit,
+	}, nil
+}
+
+// setJSONHeader sends a value the response message has no field for as a
+// JSON header.
+func setJSONHeader(ctx context.Context, key string, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return status.Error(codes.Internal, "error marshalling "+key+". Details: "+err.Error())
+	}
+
+	return grpc.SetHeader(ctx, metadata.Pairs(key, string(b)))
+}
+
+// setReactionHeaders sends the per-kind counts as "kind=count" pairs sorted
+// by kind, and the viewer's own reaction when there is one.
+func setReactionHeaders(ctx context.Context, summary *models.ReactionSummary) error {
+	kinds := make([]string, 0, len(summary.Counts))
+	for kind := range summary.Counts {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	counts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		counts = append(counts, kind+"="+strconv.FormatInt(summary.Counts[kind], 10))
+	}
+
+	md := metadata.Pairs(reactionCountsMetadataKey, strings.Join(counts, ","))
+	if summary.ViewerReaction != "" {
+		md.Set(viewerReactionMetadataKey, summary.ViewerReaction)
+	}
+
+	return grpc.SetHeader(ctx, md)
+}
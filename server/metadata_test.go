@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetListPostsParamsFromMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		sortMetadataKey, "mostLiked",
+		postTypesMetadataKey, "news, ,event",
+		authorIdsMetadataKey, "user-1",
+		createdFromMetadataKey, "2026-01-02T03:04:05Z",
+		hasImageMetadataKey, "true",
+	))
+
+	params, err := getListPostsParamsFromMetadata(ctx, "cursor-1", 10)
+	if err != nil {
+		t.Fatalf("getListPostsParamsFromMetadata() error = %v", err)
+	}
+
+	if params.SortBy != "mostLiked" || params.Cursor != "cursor-1" || params.Limit != 10 {
+		t.Errorf("params = %+v, want sort mostLiked, cursor cursor-1 and limit 10", params)
+	}
+	if !reflect.DeepEqual(params.Filters.Types, []string{"news", "event"}) {
+		t.Errorf("Types = %v, want [news event]", params.Filters.Types)
+	}
+	if !reflect.DeepEqual(params.Filters.AuthorIds, []string{"user-1"}) {
+		t.Errorf("AuthorIds = %v, want [user-1]", params.Filters.AuthorIds)
+	}
+	wantCreatedFrom := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if params.Filters.CreatedFrom == nil || !params.Filters.CreatedFrom.Equal(wantCreatedFrom) {
+		t.Errorf("CreatedFrom = %v, want %v", params.Filters.CreatedFrom, wantCreatedFrom)
+	}
+	if params.Filters.CreatedTo != nil || params.Filters.UpdatedFrom != nil || params.Filters.UpdatedTo != nil {
+		t.Errorf("unset time filters = %v, %v, %v, want nil", params.Filters.CreatedTo, params.Filters.UpdatedFrom, params.Filters.UpdatedTo)
+	}
+	if params.Filters.HasImage == nil || !*params.Filters.HasImage {
+		t.Errorf("HasImage = %v, want true", params.Filters.HasImage)
+	}
+}
+
+func TestGetListPostsParamsFromMetadataInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"created from", createdFromMetadataKey},
+		{"updated to", updatedToMetadataKey},
+		{"has image", hasImageMetadataKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.key, "yesterday"))
+
+			_, err := getListPostsParamsFromMetadata(ctx, "", 0)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("getListPostsParamsFromMetadata() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestGetBoolFromMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		want     bool
+		wantCode codes.Code
+	}{
+		{"absent", metadata.MD{}, false, codes.OK},
+		{"true", metadata.Pairs(clearPublishAtMetadataKey, "true"), true, codes.OK},
+		{"false", metadata.Pairs(clearPublishAtMetadataKey, "false"), false, codes.OK},
+		{"invalid", metadata.Pairs(clearPublishAtMetadataKey, "soon"), false, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			got, err := getBoolFromMetadata(ctx, clearPublishAtMetadataKey)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("getBoolFromMetadata() error = %v, want code %v", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("getBoolFromMetadata() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"net"
+	"path"
+
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"github.com/relaunch-cot/service-post/resource/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// RateLimitInterceptor applies the limiter per caller and RPC. Anonymous
+// callers are limited by their remote address. It must run after
+// AuthenticationInterceptor so the caller is known.
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := authentication.UserIdFromContext(ctx)
+		if key == "" {
+			key = "anonymous"
+			if p, ok := peer.FromContext(ctx); ok {
+				host, _, err := net.SplitHostPort(p.Addr.String())
+				if err != nil {
+					host = p.Addr.String()
+				}
+				key = "peer:" + host
+			}
+		}
+
+		allowed, retryAfter := limiter.Allow(key, path.Base(info.FullMethod))
+		if !allowed {
+			return nil, ratelimit.Exhausted(ctx, retryAfter, "rate limit exceeded")
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -2,10 +2,15 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/relaunch-cot/lib-relaunch-cot/proto/post"
 	"github.com/relaunch-cot/service-post/handler"
+	"github.com/relaunch-cot/service-post/models"
+	"github.com/relaunch-cot/service-post/resource/authentication"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type postResource struct {
@@ -14,7 +19,19 @@ type postResource struct {
 }
 
 func (r *postResource) CreatePost(ctx context.Context, in *pb.CreatePostRequest) (*empty.Empty, error) {
-	err := r.handler.Post.CreatePost(&ctx, in)
+	options, err := getPostOptionsFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch getMetadataValue(ctx, postStatusMetadataKey) {
+	case "", models.PostStatusPublished:
+		err = r.handler.Post.CreatePost(&ctx, in, options)
+	case models.PostStatusDraft:
+		err = r.createDraft(ctx, in, options)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid post status")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -22,24 +39,194 @@ func (r *postResource) CreatePost(ctx context.Context, in *pb.CreatePostRequest)
 	return &empty.Empty{}, nil
 }
 
+// createDraft saves the post as a draft, scheduled when publish-at is set.
+func (r *postResource) createDraft(ctx context.Context, in *pb.CreatePostRequest, options *models.PostOptions) error {
+	publishAt, err := getTimeFromMetadata(ctx, publishAtMetadataKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.handler.Post.CreateDraft(&ctx, &models.PostDraftParams{
+		UserId:       in.UserId,
+		Title:        in.Title,
+		Content:      in.Content,
+		Type:         in.Type,
+		UrlImagePost: in.UrlImagePost,
+		Visibility:   options.Visibility,
+		Attachments:  options.Attachments,
+		QuotedPostId: options.QuotedPostId,
+		PublishAt:    publishAt,
+	})
+
+	return err
+}
+
 func (r *postResource) GetPost(ctx context.Context, in *pb.GetPostRequest) (*pb.GetPostResponse, error) {
-	response, err := r.handler.Post.GetPost(&ctx, in)
+	response, err := r.handler.Post.GetPost(&ctx, in, authentication.UserIdFromContext(ctx))
 	if err != nil {
 		return nil, err
 	}
+
+	err = r.setPostViewHeader(ctx, in.PostId)
+	if err != nil {
+		return nil, err
+	}
+
 	return response, nil
 }
 
+// setPostViewHeader sends the revision history the post-view metadata asks
+// for next to the current post: every revision, a single revision, or the
+// fields that changed between two revisions.
+func (r *postResource) setPostViewHeader(ctx context.Context, postId string) error {
+	viewerId := authentication.UserIdFromContext(ctx)
+
+	switch getMetadataValue(ctx, postViewMetadataKey) {
+	case "":
+		return nil
+	case models.PostViewRevisions:
+		revisions, err := r.handler.Post.GetAllRevisionsFromPost(&ctx, postId, viewerId)
+		if err != nil {
+			return err
+		}
+
+		return setJSONHeader(ctx, revisionsMetadataKey, revisions)
+	case models.PostViewRevision:
+		revisionNumber, err := getInt64FromMetadata(ctx, revisionMetadataKey)
+		if err != nil {
+			return err
+		}
+
+		revision, err := r.handler.Post.GetPostRevision(&ctx, postId, viewerId, revisionNumber)
+		if err != nil {
+			return err
+		}
+
+		return setJSONHeader(ctx, revisionsMetadataKey, []*models.PostRevision{revision})
+	case models.PostViewRevisionDiff:
+		fromRevisionNumber, err := getInt64FromMetadata(ctx, fromRevisionMetadataKey)
+		if err != nil {
+			return err
+		}
+
+		toRevisionNumber, err := getInt64FromMetadata(ctx, toRevisionMetadataKey)
+		if err != nil {
+			return err
+		}
+
+		diffs, err := r.handler.Post.DiffPostRevisions(&ctx, postId, viewerId, fromRevisionNumber, toRevisionNumber)
+		if err != nil {
+			return err
+		}
+
+		return setJSONHeader(ctx, revisionDiffMetadataKey, diffs)
+	default:
+		return status.Error(codes.InvalidArgument, "invalid post view")
+	}
+}
+
 func (r *postResource) GetAllPosts(ctx context.Context, in *empty.Empty) (*pb.GetAllPostsResponse, error) {
-	response, err := r.handler.Post.GetAllPosts(&ctx)
+	cursor, limit, err := getPaginationFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *pb.GetAllPostsResponse
+	switch getMetadataValue(ctx, feedMetadataKey) {
+	case "", models.PostFeedLatest:
+		response, err = r.handler.Post.GetAllPosts(&ctx, authentication.UserIdFromContext(ctx), cursor, limit)
+	case models.PostFeedTrending:
+		response, err = r.handler.Post.GetTrendingPosts(&ctx, authentication.UserIdFromContext(ctx), cursor, limit)
+	case models.PostFeedHome:
+		response, err = r.handler.Post.GetHomeTimeline(&ctx, authentication.UserIdFromContext(ctx), cursor, limit)
+	case models.PostFeedList:
+		var params *models.ListPostsParams
+		params, err = getListPostsParamsFromMetadata(ctx, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = r.handler.Post.ListPosts(&ctx, params)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid feed")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *postResource) GetAllPostsFromTag(ctx context.Context, in *pb.GetAllPostsFromTagRequest) (*pb.GetAllPostsResponse, error) {
+	response, err := r.handler.Post.GetAllPostsFromTag(&ctx, in.Tag, authentication.UserIdFromContext(ctx), in.Cursor, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetTrendingTags returns the most used tags over window, a duration such as
+// "24h", defaulting to the handler's window when unset.
+func (r *postResource) GetTrendingTags(ctx context.Context, in *pb.GetTrendingTagsRequest) (*pb.GetTrendingTagsResponse, error) {
+	var window time.Duration
+	if in.Window != "" {
+		var err error
+		window, err = time.ParseDuration(in.Window)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid window")
+		}
+	}
+
+	response, err := r.handler.Post.GetTrendingTags(&ctx, window, in.Limit)
 	if err != nil {
 		return nil, err
 	}
+
+	return response, nil
+}
+
+func (r *postResource) SearchPosts(ctx context.Context, in *pb.SearchPostsRequest) (*pb.GetAllPostsResponse, error) {
+	response, err := r.handler.Post.SearchPosts(&ctx, &models.SearchPostsParams{
+		ViewerId: authentication.UserIdFromContext(ctx),
+		Query:    in.Query,
+		Types:    in.Types,
+		OrderBy:  in.OrderBy,
+		Cursor:   in.Cursor,
+		Limit:    in.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return response, nil
 }
 
 func (r *postResource) GetAllPostsFromUser(ctx context.Context, in *pb.GetAllPostsFromUserRequest) (*pb.GetAllPostsFromUserResponse, error) {
-	response, err := r.handler.Post.GetAllPostsFromUser(&ctx, in)
+	cursor, limit, err := getPaginationFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *pb.GetAllPostsFromUserResponse
+	switch getMetadataValue(ctx, feedMetadataKey) {
+	case "":
+		response, err = r.handler.Post.GetAllPostsFromUser(&ctx, in, authentication.UserIdFromContext(ctx), cursor, limit)
+	case models.PostFeedDrafts:
+		response, err = r.handler.Post.GetAllDraftsFromUser(&ctx, in.UserId, cursor, limit)
+	case models.PostFeedMentions:
+		var mentions []*models.Mention
+		var nextCursor string
+		mentions, nextCursor, err = r.handler.Post.GetAllMentionsFromUser(&ctx, in.UserId, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		response = &pb.GetAllPostsFromUserResponse{NextCursor: nextCursor}
+		err = setJSONHeader(ctx, mentionsMetadataKey, mentions)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid feed")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +235,48 @@ func (r *postResource) GetAllPostsFromUser(ctx context.Context, in *pb.GetAllPos
 }
 
 func (r *postResource) UpdatePost(ctx context.Context, in *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
-	response, err := r.handler.Post.UpdatePost(&ctx, in)
+	options, err := getPostOptionsFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *pb.UpdatePostResponse
+	switch postAction := getMetadataValue(ctx, postActionMetadataKey); postAction {
+	case "", models.PostActionUpdate:
+		response, err = r.handler.Post.UpdatePost(&ctx, in, options)
+	case models.PostActionUpdateDraft:
+		var publishAt *time.Time
+		publishAt, err = getTimeFromMetadata(ctx, publishAtMetadataKey)
+		if err != nil {
+			return nil, err
+		}
+
+		var clearPublishAt bool
+		clearPublishAt, err = getBoolFromMetadata(ctx, clearPublishAtMetadataKey)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = r.handler.Post.UpdateDraft(&ctx, &models.PostDraftParams{
+			PostId:         in.PostId,
+			UserId:         in.UserId,
+			Title:          in.Title,
+			Content:        in.Content,
+			UrlImagePost:   in.UrlImagePost,
+			Visibility:     options.Visibility,
+			Attachments:    options.Attachments,
+			QuotedPostId:   options.QuotedPostId,
+			PublishAt:      publishAt,
+			ClearPublishAt: clearPublishAt,
+		})
+	default:
+		err = r.applyPostAction(ctx, postAction, in.PostId, in.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = r.getUpdatedPost(ctx, in.PostId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +284,120 @@ func (r *postResource) UpdatePost(ctx context.Context, in *pb.UpdatePostRequest)
 	return response, nil
 }
 
+// applyPostAction runs a post-action that only reports success, leaving
+// UpdatePost to load the post afterwards.
+func (r *postResource) applyPostAction(ctx context.Context, postAction, postId, userId string) error {
+	switch postAction {
+	case models.PostActionPublishDraft:
+		return r.handler.Post.PublishDraft(&ctx, postId, userId)
+	case models.PostActionRestore:
+		return r.handler.Post.RestorePost(&ctx, postId, userId)
+	default:
+		return status.Error(codes.InvalidArgument, "invalid post action")
+	}
+}
+
+// getUpdatedPost loads the post as the caller sees it after a post action,
+// for the actions that only report success.
+func (r *postResource) getUpdatedPost(ctx context.Context, postId string) (*pb.UpdatePostResponse, error) {
+	response, err := r.handler.Post.GetPost(&ctx, &pb.GetPostRequest{PostId: postId}, authentication.UserIdFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePostResponse{Post: response.Post}, nil
+}
+
+func (r *postResource) PinPost(ctx context.Context, in *pb.PinPostRequest) (*empty.Empty, error) {
+	err := r.handler.Post.PinPost(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) UnpinPost(ctx context.Context, in *pb.UnpinPostRequest) (*empty.Empty, error) {
+	err := r.handler.Post.UnpinPost(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) Repost(ctx context.Context, in *pb.RepostRequest) (*empty.Empty, error) {
+	err := r.handler.Post.Repost(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) Unrepost(ctx context.Context, in *pb.UnrepostRequest) (*empty.Empty, error) {
+	err := r.handler.Post.Unrepost(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) GetAllRepostsFromUser(ctx context.Context, in *pb.GetAllRepostsFromUserRequest) (*pb.GetAllPostsFromUserResponse, error) {
+	response, err := r.handler.Post.GetAllRepostsFromUser(&ctx, in.UserId, authentication.UserIdFromContext(ctx), in.Cursor, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *postResource) BookmarkPost(ctx context.Context, in *pb.BookmarkPostRequest) (*empty.Empty, error) {
+	err := r.handler.Post.BookmarkPost(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) RemoveBookmark(ctx context.Context, in *pb.RemoveBookmarkRequest) (*empty.Empty, error) {
+	err := r.handler.Post.RemoveBookmark(&ctx, in.PostId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) ListBookmarks(ctx context.Context, in *pb.ListBookmarksRequest) (*pb.GetAllPostsFromUserResponse, error) {
+	response, err := r.handler.Post.ListBookmarks(&ctx, in.UserId, in.Cursor, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *postResource) FollowUser(ctx context.Context, in *pb.FollowUserRequest) (*empty.Empty, error) {
+	err := r.handler.Post.FollowUser(&ctx, in.UserId, in.FollowingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) UnfollowUser(ctx context.Context, in *pb.UnfollowUserRequest) (*empty.Empty, error) {
+	err := r.handler.Post.UnfollowUser(&ctx, in.UserId, in.FollowingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (r *postResource) DeletePost(ctx context.Context, in *pb.DeletePostRequest) (*empty.Empty, error) {
 	err := r.handler.Post.DeletePost(&ctx, in)
 	if err != nil {
@@ -66,7 +408,12 @@ func (r *postResource) DeletePost(ctx context.Context, in *pb.DeletePostRequest)
 }
 
 func (r *postResource) GetAllLikesFromPost(ctx context.Context, in *pb.GetAllLikesFromPostRequest) (*pb.GetAllLikesFromPostResponse, error) {
-	response, err := r.handler.Post.GetAllLikesFromPost(&ctx, in)
+	response, reactions, err := r.handler.Post.GetAllLikesFromPost(&ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setReactionHeaders(ctx, reactions)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +422,24 @@ func (r *postResource) GetAllLikesFromPost(ctx context.Context, in *pb.GetAllLik
 }
 
 func (r *postResource) UpdateLikesFromPostOrComment(ctx context.Context, in *pb.UpdateLikesFromPostOrCommentRequest) (*pb.UpdateLikesFromPostOrCommentResponse, error) {
-	response, err := r.handler.Post.UpdateLikesFromPostOrComment(&ctx, in)
+	var response *pb.UpdateLikesFromPostOrCommentResponse
+	var reactions *models.ReactionSummary
+	var err error
+	switch getMetadataValue(ctx, likeActionMetadataKey) {
+	case "", models.LikeActionToggle:
+		response, reactions, err = r.handler.Post.UpdateLikesFromPostOrComment(&ctx, in, getMetadataValue(ctx, reactionMetadataKey))
+	case models.LikeActionLike:
+		response, reactions, err = r.handler.Post.LikePostOrComment(&ctx, in, getMetadataValue(ctx, reactionMetadataKey))
+	case models.LikeActionUnlike:
+		response, reactions, err = r.handler.Post.UnlikePostOrComment(&ctx, in)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid like action")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = setReactionHeaders(ctx, reactions)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +465,72 @@ func (r *postResource) DeleteCommentOrReply(ctx context.Context, in *pb.DeleteCo
 	return &empty.Empty{}, nil
 }
 
+func (r *postResource) ReportContent(ctx context.Context, in *pb.ReportContentRequest) (*empty.Empty, error) {
+	err := r.handler.Post.ReportContent(&ctx, &models.ReportContentParams{
+		ReporterId: in.UserId,
+		TargetType: in.TargetType,
+		TargetId:   in.TargetId,
+		Category:   in.Category,
+		Details:    in.Details,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) GetModerationQueue(ctx context.Context, in *pb.GetModerationQueueRequest) (*pb.GetModerationQueueResponse, error) {
+	response, err := r.handler.Post.GetModerationQueue(&ctx, in.UserId, in.Cursor, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *postResource) ApproveContent(ctx context.Context, in *pb.ApproveContentRequest) (*empty.Empty, error) {
+	err := r.handler.Post.ApproveContent(&ctx, &models.ModerationActionParams{
+		ModeratorId: in.UserId,
+		TargetType:  in.TargetType,
+		TargetId:    in.TargetId,
+		Reason:      in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) RemoveContent(ctx context.Context, in *pb.RemoveContentRequest) (*empty.Empty, error) {
+	err := r.handler.Post.RemoveContent(&ctx, &models.ModerationActionParams{
+		ModeratorId: in.UserId,
+		TargetType:  in.TargetType,
+		TargetId:    in.TargetId,
+		Reason:      in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (r *postResource) RestoreContent(ctx context.Context, in *pb.RestoreContentRequest) (*empty.Empty, error) {
+	err := r.handler.Post.RestoreContent(&ctx, &models.ModerationActionParams{
+		ModeratorId: in.UserId,
+		TargetType:  in.TargetType,
+		TargetId:    in.TargetId,
+		Reason:      in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (r *postResource) GetAllCommentsFromPost(ctx context.Context, in *pb.GetAllCommentsFromPostRequest) (*pb.GetAllCommentsFromPostResponse, error) {
 	response, err := r.handler.Post.GetAllCommentsFromPost(&ctx, in)
 	if err != nil {
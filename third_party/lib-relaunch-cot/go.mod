@@ -0,0 +1,17 @@
+module github.com/relaunch-cot/lib-relaunch-cot
+
+go 1.24.1
+
+require (
+	github.com/go-sql-driver/mysql v1.9.3
+	google.golang.org/grpc v1.73.0
+	google.golang.org/protobuf v1.36.6
+)
+
+require (
+	filippo.io/edwards25519 v1.1.0 // indirect
+	golang.org/x/net v0.38.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+	golang.org/x/text v0.23.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
+)
@@ -0,0 +1,30 @@
+package httpresponse
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TransformGrpcCodeToHttpStatus(err error) int {
+	st, ok := status.FromError(err)
+	if ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			return http.StatusBadRequest
+		case codes.NotFound:
+			return http.StatusNotFound
+		case codes.AlreadyExists:
+			return http.StatusConflict
+		case codes.Unauthenticated:
+			return http.StatusUnauthorized
+		case codes.PermissionDenied:
+			return http.StatusForbidden
+		default:
+			return http.StatusInternalServerError
+		}
+	}
+
+	return http.StatusInternalServerError
+}
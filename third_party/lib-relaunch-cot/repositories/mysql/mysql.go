@@ -0,0 +1,39 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+type Client struct {
+	db *sql.DB
+}
+
+var DB *sql.DB
+
+func InitMySQL(ctx context.Context, user, password, host, port, dbname string) (*Client, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true", user, password, host, port, dbname)
+
+	var err error
+	DB, err = sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open MySQL connection: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := DB.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to ping MySQL: %w", err)
+	}
+
+	client := &Client{
+		db: DB,
+	}
+
+	return client, nil
+}